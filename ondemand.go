@@ -3,22 +3,196 @@ package traefik_ondemand_plugin
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/auth"
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/client"
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/endpoints"
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/pages"
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/schedule"
 	"github.com/acouvreur/traefik-ondemand-plugin/pkg/strategy"
 )
 
 // Config the plugin configuration
 type Config struct {
-	Name        string   `yaml:"name"`
-	Names       []string `yaml:"names"`
-	ServiceUrl  string   `yaml:"serviceurl"`
-	Timeout     string   `yaml:"timeout"`
-	ErrorPage   string   `yaml:"errorpage"`
-	LoadingPage string   `yaml:"loadingpage"`
-	WaitUi      bool     `yaml:"waitui"`
-	BlockDelay  string   `yaml:"blockdelay"`
+	Name             string     `yaml:"name"`
+	Names            []string   `yaml:"names"`
+	Services         []Service  `yaml:"services"`
+	Readiness        Readiness  `yaml:"readiness"`
+	ServiceUrl       string     `yaml:"serviceurl"`
+	PathTemplate     string     `yaml:"pathtemplate"`
+	Timeout          string     `yaml:"timeout"`
+	ErrorPage        string     `yaml:"errorpage"`
+	LoadingPage      string     `yaml:"loadingpage"`
+	ErrorTemplate    string     `yaml:"errortemplate"`
+	LoadingTemplate  string     `yaml:"loadingtemplate"`
+	AssetsUrl        string     `yaml:"assetsurl"`
+	Theme            Theme      `yaml:"theme"`
+	Locale           string     `yaml:"locale"`
+	WaitUi           bool       `yaml:"waitui"`
+	BlockDelay       string     `yaml:"blockdelay"`
+	StartingCacheTTL string     `yaml:"startingcachettl"`
+	StartedCacheTTL  string     `yaml:"startedcachettl"`
+	PollWorkers      int        `yaml:"pollworkers"`
+	PollTimeout      string     `yaml:"polltimeout"`
+	Strategy         string     `yaml:"strategy"`
+	Hybrid           Hybrid     `yaml:"hybrid"`
+	Retry            Retry      `yaml:"retry"`
+	CircuitBreaker   Breaker    `yaml:"circuitbreaker"`
+	Client           Client     `yaml:"client"`
+	Auth             Auth       `yaml:"auth"`
+	Wake             Wake       `yaml:"wake"`
+	Operations       Operations `yaml:"operations"`
+	Activity         Activity   `yaml:"activity"`
+	Schedule         Schedule   `yaml:"schedule"`
+}
+
+// Service a service of the group with its own options, the options of the group are used when not set
+type Service struct {
+	Name          string   `yaml:"name"`
+	Timeout       string   `yaml:"timeout"`
+	ServiceUrl    string   `yaml:"serviceurl"`
+	ReadyReplicas int      `yaml:"readyreplicas"`
+	DependsOn     []string `yaml:"dependson"`
+}
+
+// Readiness the policy deciding when the group is ready: all, any, quorum or services
+type Readiness struct {
+	Policy   string   `yaml:"policy"`
+	Quorum   int      `yaml:"quorum"`
+	Services []string `yaml:"services"`
+}
+
+// Hybrid the rules used by the hybrid strategy to serve the loading page instead of blocking
+type Hybrid struct {
+	Methods       []string `yaml:"methods"`
+	Accept        []string `yaml:"accept"`
+	FetchModes    []string `yaml:"fetchmodes"`
+	RequestedWith []string `yaml:"requestedwith"`
+}
+
+// Retry the retry policy of the status calls to the ondemand service
+type Retry struct {
+	Attempts       int    `yaml:"attempts"`
+	InitialBackoff string `yaml:"initialbackoff"`
+	MaxBackoff     string `yaml:"maxbackoff"`
+	BudgetPercent  int    `yaml:"budgetpercent"`
+	BudgetBurst    int    `yaml:"budgetburst"`
+}
+
+// Breaker the circuit breaker protecting the ondemand service
+type Breaker struct {
+	Failures       int    `yaml:"failures"`
+	OpenDuration   string `yaml:"openduration"`
+	HalfOpenProbes int    `yaml:"halfopenprobes"`
+}
+
+// Client the HTTP client calling the ondemand service
+type Client struct {
+	Timeout             string `yaml:"timeout"`
+	DialTimeout         string `yaml:"dialtimeout"`
+	KeepAlive           string `yaml:"keepalive"`
+	TLSHandshakeTimeout string `yaml:"tlshandshaketimeout"`
+	IdleConnTimeout     string `yaml:"idleconntimeout"`
+	MaxIdleConns        int    `yaml:"maxidleconns"`
+	MaxIdleConnsPerHost int    `yaml:"maxidleconnsperhost"`
+	MaxConnsPerHost     int    `yaml:"maxconnsperhost"`
+	CAFile              string `yaml:"cafile"`
+	CertFile            string `yaml:"certfile"`
+	KeyFile             string `yaml:"keyfile"`
+	ServerName          string `yaml:"servername"`
+	InsecureSkipVerify  bool   `yaml:"insecureskipverify"`
+	Proxy               string `yaml:"proxy"`
+}
+
+// Auth the credentials sent to the ondemand service
+type Auth struct {
+	Token      string            `yaml:"token"`
+	Headers    map[string]string `yaml:"headers"`
+	HMACSecret string            `yaml:"hmacsecret"`
+}
+
+// Wake the rules deciding which requests may wake the services up
+type Wake struct {
+	Allow    RequestMatch `yaml:"allow"`
+	Deny     RequestMatch `yaml:"deny"`
+	Response WakeResponse `yaml:"response"`
+}
+
+// Operations the calls to the ondemand service, the status call also wakes the services up and records their activity
+// unless wake and activity are set
+type Operations struct {
+	Status       Operation `yaml:"status"`
+	Wake         Operation `yaml:"wake"`
+	Activity     Operation `yaml:"activity"`
+	WakeCooldown string    `yaml:"wakecooldown"`
+}
+
+// Operation a call to the ondemand service, the path template defaults to pathtemplate for the status call
+type Operation struct {
+	Method       string `yaml:"method"`
+	PathTemplate string `yaml:"pathtemplate"`
+}
+
+// Activity the rules deciding which forwarded requests record the activity of the services,
+// and how often it is reported to the ondemand service
+type Activity struct {
+	Allow    RequestMatch `yaml:"allow"`
+	Deny     RequestMatch `yaml:"deny"`
+	Interval string       `yaml:"interval"`
+}
+
+// Schedule the windows where the group is kept awake, and the windows where it cannot be woken up
+type Schedule struct {
+	TimeZone       string           `yaml:"timezone"`
+	Awake          []ScheduleWindow `yaml:"awake"`
+	Closed         []ScheduleWindow `yaml:"closed"`
+	Interval       string           `yaml:"interval"`
+	ClosedPage     string           `yaml:"closedpage"`
+	ClosedTemplate string           `yaml:"closedtemplate"`
+}
+
+// ScheduleWindow a weekly window, days in cron format such as mon-fri, from and to as HH:MM clock times
+type ScheduleWindow struct {
+	Days string `yaml:"days"`
+	From string `yaml:"from"`
+	To   string `yaml:"to"`
+}
+
+// Theme the preset of the built-in pages, with its logo, contact message and colors
+type Theme struct {
+	Name    string      `yaml:"name"`
+	LogoUrl string      `yaml:"logourl"`
+	Contact string      `yaml:"contact"`
+	Colors  ThemeColors `yaml:"colors"`
+}
+
+// ThemeColors the colors overriding the ones of the theme preset, as #rgb or #rrggbb
+type ThemeColors struct {
+	Background string `yaml:"background"`
+	Surface    string `yaml:"surface"`
+	Text       string `yaml:"text"`
+	Primary    string `yaml:"primary"`
+	Accent     string `yaml:"accent"`
+}
+
+// RequestMatch matches a request when any of its criteria matches
+type RequestMatch struct {
+	Paths        []string `yaml:"paths"`
+	Methods      []string `yaml:"methods"`
+	UserAgents   []string `yaml:"useragents"`
+	Headers      []string `yaml:"headers"`
+	SourceRanges []string `yaml:"sourceranges"`
+}
+
+// WakeResponse the response to the requests which must not wake the services while they are not started
+type WakeResponse struct {
+	Status int    `yaml:"status"`
+	Body   string `yaml:"body"`
+	Page   string `yaml:"page"`
 }
 
 // CreateConfig creates a config with its default values
@@ -29,95 +203,885 @@ func CreateConfig() *Config {
 		BlockDelay:  "1m",
 		ErrorPage:   "",
 		LoadingPage: "",
+		Locale:      pages.DefaultLocale,
+		Theme: Theme{
+			Name: pages.DefaultTheme,
+		},
+		StartingCacheTTL: "1s",
+		StartedCacheTTL:  "5s",
+		PollWorkers:      4,
+		PollTimeout:      "5s",
+		Strategy:         "",
+		Readiness: Readiness{
+			Policy: "all",
+		},
+		Hybrid: Hybrid{
+			Methods:       []string{http.MethodGet},
+			Accept:        []string{"text/html", "application/xhtml+xml"},
+			FetchModes:    []string{"navigate"},
+			RequestedWith: []string{"XMLHttpRequest"},
+		},
+		Retry: Retry{
+			Attempts:       3,
+			InitialBackoff: "100ms",
+			MaxBackoff:     "1s",
+			BudgetPercent:  20,
+			BudgetBurst:    10,
+		},
+		CircuitBreaker: Breaker{
+			Failures:       5,
+			OpenDuration:   "10s",
+			HalfOpenProbes: 1,
+		},
+		Wake: Wake{
+			Response: WakeResponse{
+				Status: http.StatusServiceUnavailable,
+			},
+		},
+		Operations: Operations{
+			WakeCooldown: "5s",
+		},
+		Activity: Activity{
+			Interval: "10s",
+		},
+		Schedule: Schedule{
+			TimeZone: "UTC",
+			Interval: "30s",
+		},
+		Client: Client{
+			Timeout:             "2s",
+			DialTimeout:         "1s",
+			KeepAlive:           "30s",
+			TLSHandshakeTimeout: "2s",
+			IdleConnTimeout:     "90s",
+			MaxIdleConns:        100,
+			MaxIdleConnsPerHost: 10,
+		},
 	}
 }
 
-// Ondemand holds the request for the on demand service
-type Ondemand struct {
-	strategy strategy.Strategy
+// templates are the page templates of the middleware, parsed once
+type templates struct {
+	loading   *pages.Template
+	errorPage *pages.Template
+	closed    *pages.Template
 }
 
-func buildRequest(url string, name string, timeout time.Duration) (string, error) {
-	request := fmt.Sprintf("%s?name=%s&timeout=%s", url, name, timeout.String())
-	return request, nil
+// Ondemand holds the request for the on demand service
+type Ondemand struct {
+	strategy  strategy.Strategy
+	endpoints http.Handler
+	// cached answers the reserved endpoints from the cache when the request cannot wake the group up
+	cached    http.Handler
+	wake      strategy.RequestRules
+	passive   http.Handler
+	scheduler *strategy.Scheduler
+	closed    http.Handler
 }
 
 // New function creates the configuration
 func New(ctx context.Context, next http.Handler, config *Config, name string) (http.Handler, error) {
-	if len(config.ServiceUrl) == 0 {
-		return nil, fmt.Errorf("serviceurl cannot be null")
+	timeout, err := time.ParseDuration(config.Timeout)
+
+	if err != nil {
+		return nil, err
 	}
 
-	if len(config.Name) != 0 && len(config.Names) != 0 {
-		return nil, fmt.Errorf("both name and names cannot be used simultaneously")
+	if len(config.Locale) != 0 && !pages.IsSupportedLocale(config.Locale) {
+		return nil, fmt.Errorf("unknown locale %s, must be one of %s", config.Locale, strings.Join(pages.Locales(), ", "))
 	}
-	var serviceNames []string
 
-	if len(config.Name) != 0 {
-		serviceNames = append(serviceNames, config.Name)
-	} else if len(config.Names) != 0 {
-		serviceNames = config.Names
-	} else {
-		return nil, fmt.Errorf("both name and names cannot be null")
+	services, err := config.getServices(timeout)
+
+	if err != nil {
+		return nil, err
 	}
 
-	timeout, err := time.ParseDuration(config.Timeout)
+	httpClient, err := config.getHTTPClient()
 
 	if err != nil {
 		return nil, err
 	}
-	var requests []string
 
-	for _, serviceName := range serviceNames {
-		request, err := buildRequest(config.ServiceUrl, serviceName, timeout)
+	retry, err := config.getRetryClient(config.getAuthClient(httpClient))
 
-		if err != nil {
-			return nil, fmt.Errorf("error while building request for %s", serviceName)
-		}
-		requests = append(requests, request)
+	if err != nil {
+		return nil, err
+	}
+
+	breaker, err := config.getBreaker(retry)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var doer client.Doer = retry
+	if breaker != nil {
+		doer = breaker
+	}
+
+	cache, err := config.getStatusCache(doer)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pollTimeout, err := parseOptionalDuration(config.PollTimeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid polltimeout: %w", err)
+	}
+
+	policy, err := config.getReadinessPolicy(services)
+
+	if err != nil {
+		return nil, err
+	}
+
+	wakeCooldown, err := parseOptionalDuration(config.Operations.WakeCooldown)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid operations.wakecooldown: %w", err)
+	}
+
+	activityInterval, err := parseOptionalDuration(config.Activity.Interval)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid activity.interval: %w", err)
+	}
+
+	actions := strategy.NewActions(doer, wakeCooldown)
+
+	// The endpoints and the passive strategy only observe the group, the strategies wake it up.
+	// Without split operations the status call wakes the services up, observing the group wakes it up too.
+	group := strategy.GroupPoller{
+		Services: services,
+		Cache:    cache,
+		Workers:  config.PollWorkers,
+		Timeout:  pollTimeout,
+		Policy:   policy,
+		Actions:  actions,
+		Activity: strategy.NewActivityBatcher(activityInterval, actions, cache),
+	}
+
+	waking := group
+	waking.Wake = true
+
+	activity, err := getRequestRules("activity", config.Activity.Allow, config.Activity.Deny)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the requests allowed to wake the group up record its activity, the passive and closed strategies forward
+	// to next directly so that denied requests and the traffic of the closed windows never keep the group awake
+	recording := &strategy.ActivityHandler{Group: group, Rules: activity, Next: next}
+
+	templates, err := config.getTemplates()
+
+	if err != nil {
+		return nil, err
+	}
+
+	strategy, err := config.getServeStrategy(waking, templates, name, recording, timeout)
+
+	if err != nil {
+		return nil, err
+	}
+
+	wake, err := getRequestRules("wake", config.Wake.Allow, config.Wake.Deny)
+
+	if err != nil {
+		return nil, err
 	}
 
-	strategy, err := config.getServeStrategy(requests, name, next, timeout)
+	passive, err := config.getPassiveStrategy(group, next)
 
 	if err != nil {
 		return nil, err
 	}
 
+	scheduler, err := config.getScheduler(waking)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The scheduler of the previous instance of the middleware keeps running otherwise after a reload
+	scheduler.Start(ctx, name)
+
+	cached := group
+	cached.CacheOnly = true
+
 	return &Ondemand{
-		strategy: strategy,
+		strategy:  strategy,
+		wake:      wake,
+		passive:   passive,
+		scheduler: scheduler,
+		closed:    config.getClosedStrategy(group, templates, name, next, scheduler),
+		endpoints: getEndpoints(group, name, breaker),
+		cached:    getEndpoints(cached, name, breaker),
 	}, nil
 }
 
-func (config *Config) getServeStrategy(requests []string, name string, next http.Handler, timeout time.Duration) (strategy.Strategy, error) {
-	if config.WaitUi {
-		return &strategy.DynamicStrategy{
-			Requests:    requests,
+func getEndpoints(group strategy.GroupPoller, name string, breaker *client.Breaker) *endpoints.Endpoints {
+	return &endpoints.Endpoints{
+		Events: &endpoints.Events{
+			Group:       group,
 			Name:        name,
-			Next:        next,
-			Timeout:     timeout,
-			ErrorPage:   config.ErrorPage,
-			LoadingPage: config.LoadingPage,
-		}, nil
+			Interval:    1 * time.Second,
+			Heartbeat:   15 * time.Second,
+			MaxDuration: 5 * time.Minute,
+		},
+		Status: &endpoints.Status{
+			Group:   group,
+			Name:    name,
+			Breaker: breaker,
+		},
+		Assets: &endpoints.Assets{
+			MaxAge: 24 * time.Hour,
+		},
+	}
+}
+
+// getServices returns the services of the group, defined by name, names or services
+func (config *Config) getServices(timeout time.Duration) ([]strategy.Service, error) {
+	definitions := 0
+	for _, defined := range []bool{len(config.Name) != 0, len(config.Names) != 0, len(config.Services) != 0} {
+		if defined {
+			definitions++
+		}
+	}
+
+	if definitions > 1 {
+		return nil, fmt.Errorf("only one of name, names and services can be used")
+	}
+
+	var definedServices []Service
+
+	if len(config.Name) != 0 {
+		definedServices = []Service{{Name: config.Name}}
+	} else if len(config.Names) != 0 {
+		for _, serviceName := range config.Names {
+			definedServices = append(definedServices, Service{Name: serviceName})
+		}
+	} else if len(config.Services) != 0 {
+		definedServices = config.Services
 	} else {
+		return nil, fmt.Errorf("name, names and services cannot all be null")
+	}
+
+	split, err := config.Operations.isSplit()
+
+	if err != nil {
+		return nil, err
+	}
+
+	statusTemplate := config.PathTemplate
+	if len(config.Operations.Status.PathTemplate) != 0 {
+		statusTemplate = config.Operations.Status.PathTemplate
+	}
+
+	builders := map[string]*client.RequestBuilder{}
+	seen := map[string]bool{}
+	var services []strategy.Service
+
+	for index, definition := range definedServices {
+		if len(definition.Name) == 0 {
+			return nil, fmt.Errorf("services[%d]: name cannot be null", index)
+		}
+
+		if seen[definition.Name] {
+			return nil, fmt.Errorf("service %s is defined more than once", definition.Name)
+		}
+		seen[definition.Name] = true
+
+		serviceTimeout := timeout
+		if len(definition.Timeout) != 0 {
+			serviceTimeout, err = time.ParseDuration(definition.Timeout)
+
+			if err != nil {
+				return nil, fmt.Errorf("service %s: invalid timeout: %w", definition.Name, err)
+			}
+		}
+
+		if definition.ReadyReplicas < 0 {
+			return nil, fmt.Errorf("service %s: readyreplicas cannot be negative", definition.Name)
+		}
+
+		serviceUrl := definition.ServiceUrl
+		if len(serviceUrl) == 0 {
+			serviceUrl = config.ServiceUrl
+		}
+
+		if len(serviceUrl) == 0 {
+			return nil, fmt.Errorf("serviceurl cannot be null")
+		}
+
+		build := func(pathTemplate string) (string, error) {
+			key := serviceUrl + " " + pathTemplate
+			builder, ok := builders[key]
+			if !ok {
+				var err error
+				builder, err = client.NewRequestBuilder(serviceUrl, pathTemplate)
+
+				if err != nil {
+					return "", err
+				}
+				builders[key] = builder
+			}
+			return builder.Build(definition.Name, serviceTimeout), nil
+		}
+
+		request, err := build(statusTemplate)
+
+		if err != nil {
+			return nil, err
+		}
+
+		service := strategy.Service{
+			Name:          definition.Name,
+			Request:       request,
+			Timeout:       serviceTimeout,
+			ReadyReplicas: definition.ReadyReplicas,
+			DependsOn:     definition.DependsOn,
+		}
+
+		if split {
+			wake, err := build(config.Operations.Wake.PathTemplate)
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid operations.wake: %w", err)
+			}
+
+			activity, err := build(config.Operations.Activity.PathTemplate)
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid operations.activity: %w", err)
+			}
+
+			service.Wake = strategy.Operation{Method: getMethod(config.Operations.Wake.Method, http.MethodPost), Request: wake}
+			service.Activity = strategy.Operation{Method: getMethod(config.Operations.Activity.Method, http.MethodPost), Request: activity}
+		}
+
+		services = append(services, service)
+	}
+
+	if err := strategy.AssignTiers(services); err != nil {
+		return nil, err
+	}
+
+	return services, nil
+}
+
+// isSplit reports whether the services are woken up and their activity recorded by dedicated calls
+func (operations Operations) isSplit() (bool, error) {
+	wake := len(operations.Wake.PathTemplate) != 0
+	activity := len(operations.Activity.PathTemplate) != 0
+
+	if wake != activity {
+		return false, fmt.Errorf("operations.wake.pathtemplate and operations.activity.pathtemplate must be set together")
+	}
+
+	if m := operations.Status.Method; len(m) != 0 && !strings.EqualFold(m, http.MethodGet) {
+		return false, fmt.Errorf("invalid operations.status.method %s, the status is only read with GET", m)
+	}
+
+	return wake, nil
+}
+
+// getMethod returns the upper-cased method, fallback when it is not set
+func getMethod(method string, fallback string) string {
+	if len(method) == 0 {
+		return fallback
+	}
+	return strings.ToUpper(method)
+}
+
+func (config *Config) getReadinessPolicy(services []strategy.Service) (strategy.ReadinessPolicy, error) {
+	switch config.Readiness.Policy {
+	case "", "all":
+		return strategy.ReadinessPolicy{}, nil
+	case "any":
+		return strategy.ReadinessPolicy{Quorum: 1}, nil
+	case "quorum":
+		if config.Readiness.Quorum < 1 || config.Readiness.Quorum > len(services) {
+			return strategy.ReadinessPolicy{}, fmt.Errorf("readiness.quorum must be between 1 and %d, the number of services", len(services))
+		}
+		return strategy.ReadinessPolicy{Quorum: config.Readiness.Quorum}, nil
+	case "services":
+		if len(config.Readiness.Services) == 0 {
+			return strategy.ReadinessPolicy{}, fmt.Errorf("readiness.services cannot be null with the services policy")
+		}
+		for _, required := range config.Readiness.Services {
+			if !containsService(services, required) {
+				return strategy.ReadinessPolicy{}, fmt.Errorf("readiness.services: %s is not part of the group", required)
+			}
+		}
+		return strategy.ReadinessPolicy{Required: config.Readiness.Services}, nil
+	default:
+		return strategy.ReadinessPolicy{}, fmt.Errorf("unknown readiness policy %s, must be one of all, any, quorum or services", config.Readiness.Policy)
+	}
+}
+
+func containsService(services []strategy.Service, name string) bool {
+	for _, service := range services {
+		if service.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (config *Config) getStatusCache(doer client.Doer) (*strategy.StatusCache, error) {
+	startingTTL, err := parseOptionalDuration(config.StartingCacheTTL)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid startingcachettl: %w", err)
+	}
+
+	startedTTL, err := parseOptionalDuration(config.StartedCacheTTL)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid startedcachettl: %w", err)
+	}
+
+	cache := strategy.NewStatusCache(startingTTL, startedTTL)
+	cache.Client = doer
 
-		blockDelay, err := time.ParseDuration(config.BlockDelay)
+	return cache, nil
+}
+
+// getHTTPClient returns the HTTP client of this middleware, its connections are not shared with other middlewares
+func (config *Config) getHTTPClient() (*http.Client, error) {
+	options := client.Options{
+		MaxIdleConns:        config.Client.MaxIdleConns,
+		MaxIdleConnsPerHost: config.Client.MaxIdleConnsPerHost,
+		MaxConnsPerHost:     config.Client.MaxConnsPerHost,
+		CAFile:              config.Client.CAFile,
+		CertFile:            config.Client.CertFile,
+		KeyFile:             config.Client.KeyFile,
+		ServerName:          config.Client.ServerName,
+		InsecureSkipVerify:  config.Client.InsecureSkipVerify,
+		Proxy:               config.Client.Proxy,
+	}
+
+	durations := []struct {
+		key    string
+		value  string
+		target *time.Duration
+	}{
+		{"timeout", config.Client.Timeout, &options.Timeout},
+		{"dialtimeout", config.Client.DialTimeout, &options.DialTimeout},
+		{"keepalive", config.Client.KeepAlive, &options.KeepAlive},
+		{"tlshandshaketimeout", config.Client.TLSHandshakeTimeout, &options.TLSHandshakeTimeout},
+		{"idleconntimeout", config.Client.IdleConnTimeout, &options.IdleConnTimeout},
+	}
+
+	for _, duration := range durations {
+		value, err := parseOptionalDuration(duration.value)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid client.%s: %w", duration.key, err)
+		}
+		*duration.target = value
+	}
+
+	// An empty timeout is only bounded by the status cache
+	if len(config.Client.Timeout) != 0 && options.Timeout <= 0 {
+		return nil, fmt.Errorf("client.timeout must be positive")
+	}
+
+	httpClient, err := client.NewHTTPClient(options)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid client: %w", err)
+	}
+
+	return httpClient, nil
+}
+
+// getAuthClient returns doer adding the configured credentials, doer itself when there are none
+func (config *Config) getAuthClient(doer client.Doer) client.Doer {
+	if len(config.Auth.Token) == 0 && len(config.Auth.Headers) == 0 && len(config.Auth.HMACSecret) == 0 {
+		return doer
+	}
+
+	authClient := &client.Auth{
+		Client:  doer,
+		Token:   config.Auth.Token,
+		Headers: config.Auth.Headers,
+	}
+
+	if len(config.Auth.HMACSecret) != 0 {
+		authClient.Signer = auth.NewSigner([]byte(config.Auth.HMACSecret))
+	}
+
+	return authClient
+}
+
+func (config *Config) getRetryClient(doer client.Doer) (*client.Retry, error) {
+	initialBackoff, err := parseOptionalDuration(config.Retry.InitialBackoff)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid retry.initialbackoff: %w", err)
+	}
+
+	maxBackoff, err := parseOptionalDuration(config.Retry.MaxBackoff)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid retry.maxbackoff: %w", err)
+	}
+
+	retry := &client.Retry{
+		Client:         doer,
+		Attempts:       config.Retry.Attempts,
+		InitialBackoff: initialBackoff,
+		MaxBackoff:     maxBackoff,
+	}
+
+	// A budget without burst would never allow a retry, it disables the budget instead
+	if config.Retry.BudgetBurst > 0 {
+		retry.Budget = client.NewBudget(float64(config.Retry.BudgetPercent)/100, float64(config.Retry.BudgetBurst))
+	}
+
+	return retry, nil
+}
+
+// getBreaker returns the circuit breaker in front of doer, nil when disabled
+func (config *Config) getBreaker(doer client.Doer) (*client.Breaker, error) {
+	if config.CircuitBreaker.Failures <= 0 {
+		return nil, nil
+	}
+
+	openDuration, err := time.ParseDuration(config.CircuitBreaker.OpenDuration)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid circuitbreaker.openduration: %w", err)
+	}
+
+	halfOpenProbes := config.CircuitBreaker.HalfOpenProbes
+	if halfOpenProbes <= 0 {
+		halfOpenProbes = 1
+	}
+
+	return client.NewBreaker(doer, config.CircuitBreaker.Failures, openDuration, halfOpenProbes), nil
+}
+
+// parseOptionalDuration parses a duration, an empty value meaning no duration
+func parseOptionalDuration(value string) (time.Duration, error) {
+	if len(value) == 0 {
+		return 0, nil
+	}
+	return time.ParseDuration(value)
+}
+
+func (config *Config) getServeStrategy(group strategy.GroupPoller, templates templates, name string, next http.Handler, timeout time.Duration) (strategy.Strategy, error) {
+	switch config.getStrategyName() {
+	case "dynamic":
+		return config.getDynamicStrategy(group, templates, name, next, timeout), nil
+	case "blocking":
+		return config.getBlockingStrategy(group, templates, name, next, timeout)
+	case "hybrid":
+		blocking, err := config.getBlockingStrategy(group, templates, name, next, timeout)
 
 		if err != nil {
 			return nil, err
 		}
 
-		return &strategy.BlockingStrategy{
-			Requests:           requests,
-			Name:               name,
-			Next:               next,
-			Timeout:            timeout,
-			BlockDelay:         blockDelay,
-			BlockCheckInterval: 1 * time.Second,
+		return &strategy.HybridStrategy{
+			Dynamic:  config.getDynamicStrategy(group, templates, name, next, timeout),
+			Blocking: blocking,
+			Rules: strategy.HybridRules{
+				Methods:       config.Hybrid.Methods,
+				Accept:        config.Hybrid.Accept,
+				FetchModes:    config.Hybrid.FetchModes,
+				RequestedWith: config.Hybrid.RequestedWith,
+			},
 		}, nil
+	default:
+		return nil, fmt.Errorf("unknown strategy %s, must be one of dynamic, blocking or hybrid", config.Strategy)
+	}
+}
+
+func getRequestRules(key string, allowMatch RequestMatch, denyMatch RequestMatch) (strategy.RequestRules, error) {
+	allow, err := allowMatch.getMatcher()
+
+	if err != nil {
+		return strategy.RequestRules{}, fmt.Errorf("invalid %s.allow: %w", key, err)
+	}
+
+	deny, err := denyMatch.getMatcher()
+
+	if err != nil {
+		return strategy.RequestRules{}, fmt.Errorf("invalid %s.deny: %w", key, err)
+	}
+
+	return strategy.RequestRules{Allow: allow, Deny: deny}, nil
+}
+
+func (match RequestMatch) getMatcher() (strategy.RequestMatcher, error) {
+	return strategy.NewRequestMatcher(match.Paths, match.Methods, match.UserAgents, match.Headers, match.SourceRanges)
+}
+
+// getPassiveStrategy returns the strategy of the requests which must not wake the services up,
+// the static page is read once
+func (config *Config) getPassiveStrategy(group strategy.GroupPoller, next http.Handler) (*strategy.PassiveStrategy, error) {
+	status := config.Wake.Response.Status
+	if status == 0 {
+		status = http.StatusServiceUnavailable
+	}
+
+	if len(http.StatusText(status)) == 0 {
+		return nil, fmt.Errorf("invalid wake.response.status %d", status)
+	}
+
+	response := strategy.SleepingResponse{
+		Status:      status,
+		ContentType: "text/plain; charset=utf-8",
+		Body:        config.Wake.Response.Body,
+	}
+
+	if len(config.Wake.Response.Page) != 0 {
+		page, err := ioutil.ReadFile(config.Wake.Response.Page)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid wake.response.page: %w", err)
+		}
+		response.ContentType = "text/html; charset=utf-8"
+		response.Body = string(page)
+	} else if len(response.Body) == 0 {
+		response.Body = http.StatusText(status) + "\n"
+	}
+
+	return &strategy.PassiveStrategy{Group: group, Next: next, Response: response}, nil
+}
+
+// getScheduler returns the scheduler of the group, nil when there is no schedule
+func (config *Config) getScheduler(group strategy.GroupPoller) (*strategy.Scheduler, error) {
+	location, err := time.LoadLocation(config.Schedule.TimeZone)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule.timezone: %w", err)
+	}
+
+	plan := schedule.Schedule{Location: location}
+
+	for index, window := range config.Schedule.Awake {
+		awake, err := schedule.ParseWindow(window.Days, window.From, window.To)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule.awake[%d]: %w", index, err)
+		}
+		plan.Awake = append(plan.Awake, awake)
+	}
+
+	for index, window := range config.Schedule.Closed {
+		closed, err := schedule.ParseWindow(window.Days, window.From, window.To)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule.closed[%d]: %w", index, err)
+		}
+		plan.Closed = append(plan.Closed, closed)
+	}
+
+	if plan.IsZero() {
+		return nil, nil
+	}
+
+	interval, err := time.ParseDuration(config.Schedule.Interval)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule.interval: %w", err)
+	}
+
+	if interval <= 0 {
+		return nil, fmt.Errorf("schedule.interval must be positive")
+	}
+
+	return strategy.NewScheduler(plan, group, interval), nil
+}
+
+func (config *Config) getClosedStrategy(group strategy.GroupPoller, templates templates, name string, next http.Handler, scheduler *strategy.Scheduler) *strategy.ClosedStrategy {
+	return &strategy.ClosedStrategy{
+		Group:      group,
+		Name:       name,
+		Next:       next,
+		ClosedPage: templates.closed,
+		Scheduler:  scheduler,
+		Locale:     config.Locale,
+		AssetsUrl:  config.AssetsUrl,
 	}
 }
 
+// getTemplates parses the page templates with the theme, the custom ones are validated
+// and then reloaded when their file changes
+func (config *Config) getTemplates() (templates, error) {
+	theme, err := config.getTheme()
+
+	if err != nil {
+		return templates{}, err
+	}
+
+	loading, err := getTemplate("loadingpage", config.LoadingPage, "loadingtemplate", config.LoadingTemplate, pages.NewLoadingTemplate, pages.ParseLoadingTemplate)
+
+	if err != nil {
+		return templates{}, err
+	}
+
+	errorPage, err := getTemplate("errorpage", config.ErrorPage, "errortemplate", config.ErrorTemplate, pages.NewErrorTemplate, pages.ParseErrorTemplate)
+
+	if err != nil {
+		return templates{}, err
+	}
+
+	closed, err := getTemplate("schedule.closedpage", config.Schedule.ClosedPage, "schedule.closedtemplate", config.Schedule.ClosedTemplate, pages.NewClosedTemplate, pages.ParseClosedTemplate)
+
+	if err != nil {
+		return templates{}, err
+	}
+
+	return templates{
+		loading:   loading.WithTheme(theme),
+		errorPage: errorPage.WithTheme(theme),
+		closed:    closed.WithTheme(theme),
+	}, nil
+}
+
+// getTemplate returns the template read from file or parsed from the inline text, only one of them can be set
+func getTemplate(fileKey string, file string, textKey string, text string, read func(string) (*pages.Template, error), parse func(string) (*pages.Template, error)) (*pages.Template, error) {
+	if len(file) != 0 && len(text) != 0 {
+		return nil, fmt.Errorf("only one of %s and %s can be used", fileKey, textKey)
+	}
+
+	if len(text) != 0 {
+		tpl, err := parse(text)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", textKey, err)
+		}
+
+		return tpl, nil
+	}
+
+	tpl, err := read(file)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", fileKey, err)
+	}
+
+	return tpl, nil
+}
+
+// getTheme returns the theme preset with the configured logo, contact message and colors
+func (config *Config) getTheme() (pages.Theme, error) {
+	name := config.Theme.Name
+	if len(name) == 0 {
+		name = pages.DefaultTheme
+	}
+
+	theme, err := pages.NewTheme(name)
+
+	if err != nil {
+		return pages.Theme{}, fmt.Errorf("invalid theme.name: %w", err)
+	}
+
+	theme.LogoUrl = config.Theme.LogoUrl
+	theme.Contact = config.Theme.Contact
+
+	colors := config.Theme.Colors
+	for _, color := range []struct {
+		name  string
+		value string
+		field *string
+	}{
+		{"background", colors.Background, &theme.Colors.Background},
+		{"surface", colors.Surface, &theme.Colors.Surface},
+		{"text", colors.Text, &theme.Colors.Text},
+		{"primary", colors.Primary, &theme.Colors.Primary},
+		{"accent", colors.Accent, &theme.Colors.Accent},
+	} {
+		if len(color.value) == 0 {
+			continue
+		}
+
+		value, err := pages.ParseColor(color.value)
+
+		if err != nil {
+			return pages.Theme{}, fmt.Errorf("invalid theme.colors.%s: %w", color.name, err)
+		}
+
+		*color.field = value
+	}
+
+	return theme, nil
+}
+
+// getStrategyName returns the configured strategy, waitui selects between dynamic and blocking when it is not set
+func (config *Config) getStrategyName() string {
+	if len(config.Strategy) != 0 {
+		return config.Strategy
+	}
+	if config.WaitUi {
+		return "dynamic"
+	}
+	return "blocking"
+}
+
+func (config *Config) getDynamicStrategy(group strategy.GroupPoller, templates templates, name string, next http.Handler, timeout time.Duration) *strategy.DynamicStrategy {
+	return &strategy.DynamicStrategy{
+		Group:       group,
+		Name:        name,
+		Next:        next,
+		Timeout:     timeout,
+		ErrorPage:   templates.errorPage,
+		LoadingPage: templates.loading,
+		Locale:      config.Locale,
+		AssetsUrl:   config.AssetsUrl,
+	}
+}
+
+func (config *Config) getBlockingStrategy(group strategy.GroupPoller, templates templates, name string, next http.Handler, timeout time.Duration) (*strategy.BlockingStrategy, error) {
+	blockDelay, err := time.ParseDuration(config.BlockDelay)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &strategy.BlockingStrategy{
+		Group:              group,
+		Name:               name,
+		Next:               next,
+		Timeout:            timeout,
+		BlockDelay:         blockDelay,
+		BlockCheckInterval: 1 * time.Second,
+		ErrorPage:          templates.errorPage,
+		Locale:             config.Locale,
+		AssetsUrl:          config.AssetsUrl,
+	}, nil
+}
+
 // ServeHTTP retrieve the service status
 func (e *Ondemand) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	_, closed := e.scheduler.ClosedUntil()
+	allowed := !closed && e.wake.Allows(req)
+
+	if _, ok := strategy.ReservedEndpoint(req.URL.Path); ok {
+		// A status call wakes the services up without split operations, so the endpoints must not query them
+		if !allowed {
+			e.cached.ServeHTTP(rw, req)
+			return
+		}
+		e.endpoints.ServeHTTP(rw, req)
+		return
+	}
+	if closed {
+		e.closed.ServeHTTP(rw, req)
+		return
+	}
+	if !allowed {
+		e.passive.ServeHTTP(rw, req)
+		return
+	}
 	e.strategy.ServeHTTP(rw, req)
 }
@@ -2,14 +2,25 @@ package traefik_ondemand_plugin
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/auth"
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/strategy"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestNewOndemand(t *testing.T) {
+	invalidErrorPage := filepath.Join(t.TempDir(), "error.html")
+	require.NoError(t, ioutil.WriteFile(invalidErrorPage, []byte(`<body>{{ .Name </body>`), 0644))
+
 	testCases := []struct {
 		desc          string
 		config        *Config
@@ -102,6 +113,509 @@ func TestNewOndemand(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			desc: "valid Hybrid Config",
+			config: &Config{
+				Name:       "whoami",
+				ServiceUrl: "http://ondemand:1000",
+				Strategy:   "hybrid",
+				BlockDelay: "1m",
+				Timeout:    "1m",
+			},
+			expectedError: false,
+		},
+		{
+			desc: "Invalid Config (hybrid without blockdelay)",
+			config: &Config{
+				Name:       "whoami",
+				ServiceUrl: "http://ondemand:1000",
+				Strategy:   "hybrid",
+				Timeout:    "1m",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (unknown strategy)",
+			config: &Config{
+				Name:       "whoami",
+				ServiceUrl: "http://ondemand:1000",
+				Strategy:   "eager",
+				BlockDelay: "1m",
+				Timeout:    "1m",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "valid Config with services",
+			config: &Config{
+				Services: []Service{
+					{Name: "db", Timeout: "1h", ServiceUrl: "http://ondemand-db:1000"},
+					{Name: "web", Timeout: "5m", ReadyReplicas: 1},
+				},
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+			},
+			expectedError: false,
+		},
+		{
+			desc: "valid Config with services having their own serviceUrl",
+			config: &Config{
+				Services: []Service{
+					{Name: "db", ServiceUrl: "http://ondemand-db:1000"},
+					{Name: "web", ServiceUrl: "http://ondemand-web:1000"},
+				},
+				WaitUi:  true,
+				Timeout: "1m",
+			},
+			expectedError: false,
+		},
+		{
+			desc: "Invalid Config (names and services used simultaneously)",
+			config: &Config{
+				Names:      []string{"web"},
+				Services:   []Service{{Name: "db"}},
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (service defined twice)",
+			config: &Config{
+				Services:   []Service{{Name: "db", Timeout: "1h"}, {Name: "db", Timeout: "5m"}},
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (name defined twice in names)",
+			config: &Config{
+				Names:      []string{"db", "db"},
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (service without name)",
+			config: &Config{
+				Services:   []Service{{Timeout: "1h"}},
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (service timeout)",
+			config: &Config{
+				Services:   []Service{{Name: "db", Timeout: "1 hour"}},
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (service without serviceUrl)",
+			config: &Config{
+				Services: []Service{{Name: "db", ServiceUrl: "http://ondemand-db:1000"}, {Name: "web"}},
+				WaitUi:   true,
+				Timeout:  "1m",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "valid Config with dependencies",
+			config: &Config{
+				Services: []Service{
+					{Name: "db"},
+					{Name: "api", DependsOn: []string{"db"}},
+					{Name: "web", DependsOn: []string{"api"}},
+				},
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+			},
+			expectedError: false,
+		},
+		{
+			desc: "Invalid Config (dependency cycle)",
+			config: &Config{
+				Services: []Service{
+					{Name: "db", DependsOn: []string{"web"}},
+					{Name: "web", DependsOn: []string{"db"}},
+				},
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (unknown dependency)",
+			config: &Config{
+				Services:   []Service{{Name: "web", DependsOn: []string{"db"}}},
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "valid Config with quorum readiness",
+			config: &Config{
+				Names:      []string{"web", "api", "search"},
+				Readiness:  Readiness{Policy: "quorum", Quorum: 2},
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+			},
+			expectedError: false,
+		},
+		{
+			desc: "valid Config with required services",
+			config: &Config{
+				Names:      []string{"web", "api", "search"},
+				Readiness:  Readiness{Policy: "services", Services: []string{"web", "api"}},
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+			},
+			expectedError: false,
+		},
+		{
+			desc: "Invalid Config (quorum above the number of services)",
+			config: &Config{
+				Names:      []string{"web", "api"},
+				Readiness:  Readiness{Policy: "quorum", Quorum: 3},
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (unknown required service)",
+			config: &Config{
+				Names:      []string{"web", "api"},
+				Readiness:  Readiness{Policy: "services", Services: []string{"db"}},
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (unknown readiness policy)",
+			config: &Config{
+				Name:       "web",
+				Readiness:  Readiness{Policy: "most"},
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (wake user agent pattern)",
+			config: &Config{
+				Name:       "whoami",
+				Wake:       Wake{Deny: RequestMatch{UserAgents: []string{"(bot"}}},
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (missing wake page)",
+			config: &Config{
+				Name:       "whoami",
+				Wake:       Wake{Response: WakeResponse{Page: "/does/not/exist/asleep.html"}},
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (negative readyreplicas)",
+			config: &Config{
+				Services:   []Service{{Name: "db", ReadyReplicas: -1}},
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (serviceUrl without scheme)",
+			config: &Config{
+				Name:       "whoami",
+				ServiceUrl: "ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (unknown path template placeholder)",
+			config: &Config{
+				Name:         "whoami",
+				ServiceUrl:   "http://ondemand:1000",
+				PathTemplate: "/services/{service}/wake",
+				WaitUi:       true,
+				Timeout:      "1m",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "valid Config with path template",
+			config: &Config{
+				Name:         "whoami",
+				ServiceUrl:   "http://ondemand:1000/api",
+				PathTemplate: "/v2/services/{name}/wake",
+				WaitUi:       true,
+				Timeout:      "1m",
+			},
+			expectedError: false,
+		},
+		{
+			desc: "Invalid Config (client timeout)",
+			config: &Config{
+				Name:       "whoami",
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+				Client:     Client{Timeout: "2 seconds"},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (no client timeout)",
+			config: &Config{
+				Name:       "whoami",
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+				Client:     Client{Timeout: "0s"},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (missing client cafile)",
+			config: &Config{
+				Name:       "whoami",
+				ServiceUrl: "https://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+				Client:     Client{CAFile: "/does/not/exist/ca.pem"},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "valid Config with operations",
+			config: &Config{
+				Name:       "whoami",
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+				Operations: Operations{
+					Status:   Operation{PathTemplate: "/services/{name}"},
+					Wake:     Operation{PathTemplate: "/services/{name}/wake"},
+					Activity: Operation{Method: "put", PathTemplate: "/services/{name}/activity"},
+				},
+				Activity: Activity{Deny: RequestMatch{Paths: []string{"/healthz"}}},
+			},
+			expectedError: false,
+		},
+		{
+			desc: "Invalid Config (wake operation without activity operation)",
+			config: &Config{
+				Name:       "whoami",
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+				Operations: Operations{Wake: Operation{PathTemplate: "/services/{name}/wake"}},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (status operation method)",
+			config: &Config{
+				Name:       "whoami",
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+				Operations: Operations{Status: Operation{Method: http.MethodPost}},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (wake cooldown)",
+			config: &Config{
+				Name:       "whoami",
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+				Operations: Operations{WakeCooldown: "5 seconds"},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "valid Config with schedule",
+			config: &Config{
+				Name:       "whoami",
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+				Schedule: Schedule{
+					TimeZone: "Europe/Paris",
+					Interval: "30s",
+					Awake:    []ScheduleWindow{{Days: "mon-fri", From: "08:00", To: "19:00"}},
+					Closed:   []ScheduleWindow{{Days: "sat,sun", From: "00:00", To: "24:00"}},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			desc: "Invalid Config (schedule time zone)",
+			config: &Config{
+				Name:       "whoami",
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+				Schedule:   Schedule{TimeZone: "Europe/Nowhere", Interval: "30s"},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (schedule window)",
+			config: &Config{
+				Name:       "whoami",
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+				Schedule: Schedule{
+					Interval: "30s",
+					Closed:   []ScheduleWindow{{Days: "weekend", From: "00:00", To: "24:00"}},
+				},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (missing loading page)",
+			config: &Config{
+				Name:        "whoami",
+				ServiceUrl:  "http://ondemand:1000",
+				WaitUi:      true,
+				Timeout:     "1m",
+				LoadingPage: "/does/not/exist/loading.html",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (invalid error page)",
+			config: &Config{
+				Name:       "whoami",
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+				ErrorPage:  invalidErrorPage,
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Valid Config (inline templates and theme)",
+			config: &Config{
+				Name:            "whoami",
+				ServiceUrl:      "http://ondemand:1000",
+				WaitUi:          true,
+				Timeout:         "1m",
+				LoadingTemplate: `{{ .Name }} {{ .Theme.Contact }}`,
+				ErrorTemplate:   `{{ .Name }} {{ .Error }}`,
+				Theme: Theme{
+					Name:    "corporate",
+					LogoUrl: "https://example.com/logo.svg",
+					Contact: "Contact the platform team",
+					Colors:  ThemeColors{Primary: "#c00", Background: "#FAFAFA"},
+				},
+			},
+			expectedError: false,
+		},
+		{
+			desc: "Invalid Config (invalid inline loading template)",
+			config: &Config{
+				Name:            "whoami",
+				ServiceUrl:      "http://ondemand:1000",
+				WaitUi:          true,
+				Timeout:         "1m",
+				LoadingTemplate: `{{ .Name `,
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (error page file and inline template)",
+			config: &Config{
+				Name:          "whoami",
+				ServiceUrl:    "http://ondemand:1000",
+				WaitUi:        true,
+				Timeout:       "1m",
+				ErrorPage:     "pkg/pages/error.html",
+				ErrorTemplate: `{{ .Error }}`,
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (unknown theme)",
+			config: &Config{
+				Name:       "whoami",
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+				Theme:      Theme{Name: "neon"},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (invalid theme color)",
+			config: &Config{
+				Name:       "whoami",
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+				Theme:      Theme{Colors: ThemeColors{Accent: "red;}"}},
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (unknown locale)",
+			config: &Config{
+				Name:       "whoami",
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+				Locale:     "de",
+			},
+			expectedError: true,
+		},
+		{
+			desc: "Invalid Config (activity path pattern)",
+			config: &Config{
+				Name:       "whoami",
+				ServiceUrl: "http://ondemand:1000",
+				WaitUi:     true,
+				Timeout:    "1m",
+				Activity:   Activity{Allow: RequestMatch{Paths: []string{"["}}},
+			},
+			expectedError: true,
+		},
 	}
 
 	for _, test := range testCases {
@@ -121,3 +635,318 @@ func TestNewOndemand(t *testing.T) {
 		})
 	}
 }
+
+func TestOndemand_ReservedEndpoints(t *testing.T) {
+	nextCalled := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nextCalled = true
+	})
+
+	config := CreateConfig()
+	config.Name = "whoami"
+	config.ServiceUrl = "http://ondemand:1000"
+
+	ondemand, err := New(context.Background(), next, config, "traefikTest")
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "http://mydomain/whoami/__ondemand/unknown", nil)
+
+	ondemand.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.False(t, nextCalled)
+}
+
+func TestOndemand_StatusEndpoint(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "started")
+	}))
+	defer mockServer.Close()
+
+	nextCalled := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nextCalled = true
+	})
+
+	config := CreateConfig()
+	config.Names = []string{"whoami-1", "whoami-2"}
+	config.ServiceUrl = mockServer.URL
+
+	ondemand, err := New(context.Background(), next, config, "traefikTest")
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "http://mydomain/whoami/__ondemand/status", nil)
+
+	ondemand.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	assert.Contains(t, recorder.Body.String(), `"name":"whoami-1"`)
+	assert.Contains(t, recorder.Body.String(), `"name":"whoami-2"`)
+	assert.False(t, nextCalled)
+}
+
+func TestOndemand_Auth(t *testing.T) {
+	verifier := auth.NewVerifier([]byte("s3cr3t"), 30*time.Second)
+	mockServer := httptest.NewServer(verifier.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, "started")
+	})))
+	defer mockServer.Close()
+
+	nextCalled := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nextCalled = true
+	})
+
+	config := CreateConfig()
+	config.Name = "whoami"
+	config.ServiceUrl = mockServer.URL
+	config.Auth = Auth{Token: "token", HMACSecret: "s3cr3t"}
+
+	ondemand, err := New(context.Background(), next, config, "traefikTest")
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	ondemand.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://mydomain/whoami", nil))
+
+	assert.True(t, nextCalled)
+}
+
+func TestConfig_getServices(t *testing.T) {
+	config := CreateConfig()
+	config.ServiceUrl = "http://ondemand:10000"
+	config.Services = []Service{
+		{Name: "db", Timeout: "1h", ServiceUrl: "http://ondemand-db:10000"},
+		{Name: "web", ReadyReplicas: 2},
+	}
+
+	services, err := config.getServices(5 * time.Minute)
+	require.NoError(t, err)
+
+	assert.Equal(t, []strategy.Service{
+		{Name: "db", Request: "http://ondemand-db:10000?name=db&timeout=1h0m0s", Timeout: time.Hour},
+		{Name: "web", Request: "http://ondemand:10000?name=web&timeout=5m0s", Timeout: 5 * time.Minute, ReadyReplicas: 2},
+	}, services)
+}
+
+func TestOndemand_Wake(t *testing.T) {
+	var calls int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		fmt.Fprint(w, "starting")
+	}))
+	defer mockServer.Close()
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	config := CreateConfig()
+	config.Name = "whoami"
+	config.ServiceUrl = mockServer.URL
+	config.Wake.Deny = RequestMatch{Paths: []string{"/robots.txt"}, UserAgents: []string{"(?i)bot"}}
+	config.Wake.Response = WakeResponse{Status: http.StatusNotFound, Body: "asleep"}
+
+	ondemand, err := New(context.Background(), next, config, "traefikTest")
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	ondemand.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://mydomain/robots.txt", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Equal(t, "asleep", recorder.Body.String())
+	assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+
+	recorder = httptest.NewRecorder()
+	ondemand.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://mydomain/", nil))
+	assert.Equal(t, http.StatusAccepted, recorder.Code)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestOndemand_Theme(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "starting")
+	}))
+	defer mockServer.Close()
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	config := CreateConfig()
+	config.Name = "whoami"
+	config.ServiceUrl = mockServer.URL
+	config.Theme = Theme{
+		Name:    "dark",
+		LogoUrl: "https://example.com/logo.svg",
+		Contact: "Contact the platform team",
+		Colors:  ThemeColors{Primary: "#C00"},
+	}
+
+	ondemand, err := New(context.Background(), next, config, "traefikTest")
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	ondemand.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://mydomain/", nil))
+
+	assert.Equal(t, http.StatusAccepted, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "--color-Wayne6: #0d1117;")
+	assert.Contains(t, recorder.Body.String(), "--color-Raven: #cc0000;")
+	assert.Contains(t, recorder.Body.String(), `<img class="logo" src="https://example.com/logo.svg" alt="" />`)
+	assert.Contains(t, recorder.Body.String(), "Contact the platform team")
+}
+
+func TestOndemand_Wake_NoActivity(t *testing.T) {
+	var calls int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		fmt.Fprint(w, "started")
+	}))
+	defer mockServer.Close()
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "ok")
+	})
+
+	config := CreateConfig()
+	config.Name = "whoami"
+	config.ServiceUrl = mockServer.URL
+	config.Wake.Deny = RequestMatch{Paths: []string{"/robots.txt"}, UserAgents: []string{"^UptimeRobot/"}}
+
+	ondemand, err := New(context.Background(), next, config, "traefikTest")
+	require.NoError(t, err)
+
+	// Without split operations a status call wakes the service up, the denied status requests only read the cache
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "http://mydomain/__ondemand/status", nil)
+	req.Header.Set("User-Agent", "UptimeRobot/2.0")
+	ondemand.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"state":"unknown"`)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+
+	// The allowed status requests wake the service up
+	recorder = httptest.NewRecorder()
+	ondemand.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://mydomain/__ondemand/status", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	// The denied request is forwarded to the started service without recording its activity,
+	// which would refresh the status and keep the service awake
+	recorder = httptest.NewRecorder()
+	ondemand.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://mydomain/robots.txt", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "ok", recorder.Body.String())
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestOndemand_StatusEndpoint_Closed(t *testing.T) {
+	var calls int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		fmt.Fprint(w, "started")
+	}))
+	defer mockServer.Close()
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	config := CreateConfig()
+	config.Name = "whoami"
+	config.ServiceUrl = mockServer.URL
+	config.Schedule.Closed = []ScheduleWindow{{Days: "*", From: "00:00", To: "24:00"}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ondemand, err := New(ctx, next, config, "TestOndemand_StatusEndpoint_Closed")
+	require.NoError(t, err)
+
+	// The status call would wake the service up, the endpoint only reads the cache during the closed windows
+	recorder := httptest.NewRecorder()
+	ondemand.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://mydomain/__ondemand/status", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"state":"unknown"`)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+}
+
+func TestConfig_getServices_Operations(t *testing.T) {
+	config := CreateConfig()
+	config.Name = "web"
+	config.ServiceUrl = "http://ondemand:10000/api"
+	config.Operations = Operations{
+		Status:   Operation{PathTemplate: "/services/{name}"},
+		Wake:     Operation{PathTemplate: "/services/{name}/wake"},
+		Activity: Operation{Method: "put", PathTemplate: "/services/{name}/activity"},
+	}
+
+	services, err := config.getServices(5 * time.Minute)
+	require.NoError(t, err)
+
+	assert.Equal(t, []strategy.Service{{
+		Name:     "web",
+		Request:  "http://ondemand:10000/api/services/web?timeout=5m0s",
+		Timeout:  5 * time.Minute,
+		Wake:     strategy.Operation{Method: http.MethodPost, Request: "http://ondemand:10000/api/services/web/wake?timeout=5m0s"},
+		Activity: strategy.Operation{Method: http.MethodPut, Request: "http://ondemand:10000/api/services/web/activity?timeout=5m0s"},
+	}}, services)
+}
+
+func TestOndemand_Operations(t *testing.T) {
+	var state atomic.Value
+	state.Store("stopped")
+	var wakes, activities int32
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/services/whoami/wake":
+			atomic.AddInt32(&wakes, 1)
+			state.Store("started")
+		case "/services/whoami/activity":
+			atomic.AddInt32(&activities, 1)
+		default:
+			fmt.Fprint(w, state.Load())
+		}
+	}))
+	defer mockServer.Close()
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	config := CreateConfig()
+	config.Name = "whoami"
+	config.ServiceUrl = mockServer.URL
+	config.StartedCacheTTL = ""
+	config.Operations.Wake.PathTemplate = "/services/{name}/wake"
+	config.Operations.Activity.PathTemplate = "/services/{name}/activity"
+	config.Wake.Deny = RequestMatch{Paths: []string{"/healthz"}}
+	config.Activity.Deny = RequestMatch{Paths: []string{"/healthz"}}
+
+	ondemand, err := New(context.Background(), next, config, "traefikTest")
+	require.NoError(t, err)
+
+	// Observing the group neither wakes it up nor records its activity
+	recorder := httptest.NewRecorder()
+	ondemand.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://mydomain/__ondemand/status", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&wakes))
+
+	recorder = httptest.NewRecorder()
+	ondemand.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://mydomain/", nil))
+	assert.Equal(t, http.StatusAccepted, recorder.Code)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&wakes))
+
+	recorder = httptest.NewRecorder()
+	ondemand.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://mydomain/", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	// The health checks are forwarded once the group is started, without recording its activity
+	recorder = httptest.NewRecorder()
+	ondemand.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://mydomain/healthz", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&activities) == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&wakes))
+}
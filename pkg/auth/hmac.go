@@ -0,0 +1,135 @@
+// Package auth signs the calls of the plugin to the ondemand service and verifies them on the service side,
+// so that both ends share one implementation.
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// TimestampHeader carries the unix time of the signature, in seconds
+	TimestampHeader = "X-Ondemand-Timestamp"
+	// SignatureHeader carries the versioned signature, e.g. v1=5257a869e7...
+	SignatureHeader = "X-Ondemand-Signature"
+
+	signatureVersion = "v1"
+)
+
+var (
+	ErrMissingSignature = errors.New("missing signature")
+	ErrExpiredSignature = errors.New("signature timestamp outside of the replay window")
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// Signer signs requests with HMAC-SHA256 over the method, path, query and timestamp
+type Signer struct {
+	Secret []byte
+
+	// now is replaced in tests
+	now func() time.Time
+}
+
+// NewSigner creates a signer with the shared secret
+func NewSigner(secret []byte) *Signer {
+	return &Signer{Secret: secret, now: time.Now}
+}
+
+// Sign sets the timestamp and signature headers of the request
+func (s *Signer) Sign(req *http.Request) {
+	timestamp := strconv.FormatInt(s.now().Unix(), 10)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, signatureVersion+"="+Signature(s.Secret, req.Method, req.URL.EscapedPath(), req.URL.RawQuery, timestamp))
+}
+
+// Verifier checks the signature of the requests received by the ondemand service
+type Verifier struct {
+	Secret []byte
+	// Window is the maximum difference between the signature timestamp and the current time
+	Window time.Duration
+
+	// now is replaced in tests
+	now func() time.Time
+}
+
+// NewVerifier creates a verifier with the shared secret and the replay window
+func NewVerifier(secret []byte, window time.Duration) *Verifier {
+	return &Verifier{Secret: secret, Window: window, now: time.Now}
+}
+
+// Verify returns nil when the request is signed with the shared secret within the replay window
+func (v *Verifier) Verify(req *http.Request) error {
+	timestamp := req.Header.Get(TimestampHeader)
+	header := req.Header.Get(SignatureHeader)
+	if len(timestamp) == 0 || len(header) == 0 {
+		return ErrMissingSignature
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	skew := v.now().Sub(time.Unix(seconds, 0))
+	if skew > v.Window || skew < -v.Window {
+		return ErrExpiredSignature
+	}
+
+	version := signatureVersion + "="
+	if !strings.HasPrefix(header, version) {
+		return ErrInvalidSignature
+	}
+
+	expected := Signature(v.Secret, req.Method, req.URL.EscapedPath(), req.URL.RawQuery, timestamp)
+	if !hmac.Equal([]byte(strings.TrimPrefix(header, version)), []byte(expected)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Middleware answers 401 to the requests failing the verification
+func (v *Verifier) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if err := v.Verify(req); err != nil {
+			http.Error(rw, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(rw, req)
+	})
+}
+
+// Signature returns the hex encoded HMAC-SHA256 of the canonical request.
+// The query parameters are sorted by key, so that both ends agree whatever the order they were sent in.
+func Signature(secret []byte, method string, path string, rawQuery string, timestamp string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(CanonicalRequest(method, path, rawQuery, timestamp)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// CanonicalRequest returns the signed string, one field per line
+func CanonicalRequest(method string, path string, rawQuery string, timestamp string) string {
+	if len(path) == 0 {
+		path = "/"
+	}
+	return strings.Join([]string{signatureVersion, strings.ToUpper(method), path, canonicalQuery(rawQuery), timestamp}, "\n")
+}
+
+func canonicalQuery(rawQuery string) string {
+	parts := strings.Split(rawQuery, "&")
+	sorted := parts[:0]
+	for _, part := range parts {
+		if len(part) != 0 {
+			sorted = append(sorted, part)
+		}
+	}
+	sort.Strings(sorted)
+	return strings.Join(sorted, "&")
+}
@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// vector is a signature shared with the ondemand service implementation, see testdata/vectors.json
+type vector struct {
+	Desc      string `json:"desc"`
+	Secret    string `json:"secret"`
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	Query     string `json:"query"`
+	Timestamp string `json:"timestamp"`
+	Canonical string `json:"canonical"`
+	Signature string `json:"signature"`
+}
+
+func loadVectors(t *testing.T) []vector {
+	content, err := ioutil.ReadFile("testdata/vectors.json")
+	require.NoError(t, err)
+
+	var vectors []vector
+	require.NoError(t, json.Unmarshal(content, &vectors))
+	require.NotEmpty(t, vectors)
+	return vectors
+}
+
+func TestSignature_Vectors(t *testing.T) {
+	for _, test := range loadVectors(t) {
+		test := test
+		t.Run(test.Desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.Canonical, CanonicalRequest(test.Method, test.Path, test.Query, test.Timestamp))
+			assert.Equal(t, test.Signature, Signature([]byte(test.Secret), test.Method, test.Path, test.Query, test.Timestamp))
+		})
+	}
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	signedAt := time.Unix(1622541600, 0)
+
+	testCases := []struct {
+		desc     string
+		sign     func(req *http.Request)
+		now      time.Time
+		expected error
+	}{
+		{
+			desc:     "valid signature",
+			sign:     signWith("s3cr3t", signedAt),
+			now:      signedAt.Add(10 * time.Second),
+			expected: nil,
+		},
+		{
+			desc:     "clock skew within the window",
+			sign:     signWith("s3cr3t", signedAt),
+			now:      signedAt.Add(-10 * time.Second),
+			expected: nil,
+		},
+		{
+			desc:     "replayed after the window",
+			sign:     signWith("s3cr3t", signedAt),
+			now:      signedAt.Add(31 * time.Second),
+			expected: ErrExpiredSignature,
+		},
+		{
+			desc:     "wrong secret",
+			sign:     signWith("guessed", signedAt),
+			now:      signedAt,
+			expected: ErrInvalidSignature,
+		},
+		{
+			desc:     "unsigned",
+			sign:     func(req *http.Request) {},
+			now:      signedAt,
+			expected: ErrMissingSignature,
+		},
+		{
+			desc: "tampered query",
+			sign: func(req *http.Request) {
+				signWith("s3cr3t", signedAt)(req)
+				req.URL.RawQuery = "name=db&timeout=1m0s"
+			},
+			now:      signedAt,
+			expected: ErrInvalidSignature,
+		},
+		{
+			desc: "tampered timestamp",
+			sign: func(req *http.Request) {
+				signWith("s3cr3t", signedAt)(req)
+				req.Header.Set(TimestampHeader, "1622541610")
+			},
+			now:      signedAt,
+			expected: ErrInvalidSignature,
+		},
+		{
+			desc: "unknown version",
+			sign: func(req *http.Request) {
+				signWith("s3cr3t", signedAt)(req)
+				req.Header.Set(SignatureHeader, "v2="+Signature([]byte("s3cr3t"), req.Method, req.URL.EscapedPath(), req.URL.RawQuery, "1622541600"))
+			},
+			now:      signedAt,
+			expected: ErrInvalidSignature,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "http://ondemand:10000/api?name=whoami&timeout=1m0s", nil)
+			test.sign(req)
+
+			verifier := NewVerifier([]byte("s3cr3t"), 30*time.Second)
+			verifier.now = func() time.Time { return test.now }
+
+			assert.Equal(t, test.expected, verifier.Verify(req))
+		})
+	}
+}
+
+func TestVerifier_Middleware(t *testing.T) {
+	verifier := NewVerifier([]byte("s3cr3t"), 30*time.Second)
+	handler := verifier.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("started"))
+	}))
+
+	signed := httptest.NewRequest(http.MethodGet, "http://ondemand:10000/api?name=whoami", nil)
+	NewSigner([]byte("s3cr3t")).Sign(signed)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, signed)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "started", recorder.Body.String())
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://ondemand:10000/api?name=whoami", nil))
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+}
+
+func signWith(secret string, at time.Time) func(req *http.Request) {
+	return func(req *http.Request) {
+		signer := NewSigner([]byte(secret))
+		signer.now = func() time.Time { return at }
+		signer.Sign(req)
+	}
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/auth"
+)
+
+// Auth adds the credentials of the plugin to the requests sent to the ondemand service.
+// It must wrap the HTTP client directly, so that every retried attempt is signed with a fresh timestamp.
+type Auth struct {
+	Client Doer
+	// Token is sent as a bearer token in the Authorization header
+	Token string
+	// Headers are static headers, e.g. an API key expected by a gateway in front of the ondemand service
+	Headers map[string]string
+	// Signer signs the requests with HMAC, nil disables the signature
+	Signer *auth.Signer
+}
+
+// Do send a copy of the request carrying the credentials
+func (a *Auth) Do(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+
+	for name, value := range a.Headers {
+		req.Header.Set(name, value)
+	}
+
+	if len(a.Token) != 0 {
+		req.Header.Set("Authorization", "Bearer "+a.Token)
+	}
+
+	if a.Signer != nil {
+		a.Signer.Sign(req)
+	}
+
+	return a.Client.Do(req)
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuth_Do(t *testing.T) {
+	verifier := auth.NewVerifier([]byte("s3cr3t"), 30*time.Second)
+
+	var received http.Header
+	mockServer := httptest.NewServer(verifier.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header
+		fmt.Fprint(w, "started")
+	})))
+	defer mockServer.Close()
+
+	doer := &Auth{
+		Client:  http.DefaultClient,
+		Token:   "token",
+		Headers: map[string]string{"X-Api-Key": "key"},
+		Signer:  auth.NewSigner([]byte("s3cr3t")),
+	}
+
+	req, err := http.NewRequest(http.MethodGet, mockServer.URL+"/?name=whoami&timeout=1m0s", nil)
+	require.NoError(t, err)
+
+	resp, err := doer.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "Bearer token", received.Get("Authorization"))
+	assert.Equal(t, "key", received.Get("X-Api-Key"))
+	assert.Empty(t, req.Header, "the request of the caller is left untouched")
+}
+
+func TestAuth_Do_WrongSecret(t *testing.T) {
+	verifier := auth.NewVerifier([]byte("s3cr3t"), 30*time.Second)
+	mockServer := httptest.NewServer(verifier.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})))
+	defer mockServer.Close()
+
+	doer := &Auth{Client: http.DefaultClient, Signer: auth.NewSigner([]byte("guessed"))}
+
+	req, err := http.NewRequest(http.MethodGet, mockServer.URL+"/?name=whoami", nil)
+	require.NoError(t, err)
+
+	resp, err := doer.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without calling the ondemand service while its circuit is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+const (
+	CircuitClosed   = "closed"
+	CircuitOpen     = "open"
+	CircuitHalfOpen = "half-open"
+)
+
+// Breaker is a circuit breaker per ondemand service, identified by the scheme and host of the requests.
+// The circuit opens after Failures consecutive failures, then requests fail fast with ErrCircuitOpen.
+// After OpenDuration, up to HalfOpenProbes requests are let through: the circuit closes if they succeed
+// and opens again if they fail.
+type Breaker struct {
+	Client         Doer
+	Failures       int
+	OpenDuration   time.Duration
+	HalfOpenProbes int
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+
+	// now is replaced in tests
+	now func() time.Time
+}
+
+type circuit struct {
+	state    string
+	failures int
+	since    time.Time
+	probes   int
+	// halfOpens counts the half-open periods, a probe only frees its slot in the period it was let through
+	halfOpens int
+}
+
+// admission is a request let through by a circuit
+type admission struct {
+	// probe is set for the requests let through while the circuit is half-open, during the halfOpen period
+	probe    bool
+	halfOpen int
+}
+
+// CircuitState describes the circuit of an ondemand service
+type CircuitState struct {
+	Service  string    `json:"service"`
+	State    string    `json:"state"`
+	Failures int       `json:"failures"`
+	Since    time.Time `json:"since"`
+}
+
+// NewBreaker creates a circuit breaker in front of client
+func NewBreaker(client Doer, failures int, openDuration time.Duration, halfOpenProbes int) *Breaker {
+	return &Breaker{
+		Client:         client,
+		Failures:       failures,
+		OpenDuration:   openDuration,
+		HalfOpenProbes: halfOpenProbes,
+		circuits:       make(map[string]*circuit),
+		now:            time.Now,
+	}
+}
+
+// Do send the request unless the circuit of the ondemand service is open
+func (b *Breaker) Do(req *http.Request) (*http.Response, error) {
+	service := req.URL.Scheme + "://" + req.URL.Host
+
+	admitted, ok := b.allow(service)
+	if !ok {
+		return nil, fmt.Errorf("%w for %s", ErrCircuitOpen, service)
+	}
+
+	resp, err := b.Client.Do(req)
+
+	// A request abandoned by the caller says nothing about the ondemand service
+	if err != nil && req.Context().Err() != nil {
+		b.release(service, admitted)
+	} else {
+		b.record(service, admitted, !isServerFailure(resp, err))
+	}
+
+	return resp, err
+}
+
+// States returns the circuit of every ondemand service called so far
+func (b *Breaker) States() []CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	states := make([]CircuitState, 0, len(b.circuits))
+	for service, c := range b.circuits {
+		states = append(states, CircuitState{Service: service, State: c.state, Failures: c.failures, Since: c.since})
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].Service < states[j].Service })
+	return states
+}
+
+func (b *Breaker) allow(service string) (admission, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, ok := b.circuits[service]
+	if !ok {
+		c = &circuit{state: CircuitClosed, since: b.now()}
+		b.circuits[service] = c
+	}
+
+	switch c.state {
+	case CircuitOpen:
+		if b.now().Sub(c.since) < b.OpenDuration {
+			return admission{}, false
+		}
+		b.transition(service, c, CircuitHalfOpen)
+		fallthrough
+	case CircuitHalfOpen:
+		if c.probes >= b.HalfOpenProbes {
+			return admission{}, false
+		}
+		c.probes++
+		return admission{probe: true, halfOpen: c.halfOpens}, true
+	default:
+		return admission{}, true
+	}
+}
+
+func (b *Breaker) record(service string, admitted admission, success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := b.circuits[service]
+	c.endProbe(admitted)
+
+	if success {
+		c.failures = 0
+		if c.state != CircuitClosed {
+			b.transition(service, c, CircuitClosed)
+		}
+		return
+	}
+
+	c.failures++
+	if c.state == CircuitHalfOpen || (c.state == CircuitClosed && c.failures >= b.Failures) {
+		b.transition(service, c, CircuitOpen)
+	}
+}
+
+func (b *Breaker) release(service string, admitted admission) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.circuits[service].endProbe(admitted)
+}
+
+// endProbe frees the probe slot of the request, the requests let through before the current half-open period have none
+func (c *circuit) endProbe(admitted admission) {
+	if admitted.probe && c.state == CircuitHalfOpen && c.halfOpens == admitted.halfOpen {
+		c.probes--
+	}
+}
+
+func (b *Breaker) transition(service string, c *circuit, state string) {
+	if state == CircuitOpen {
+		log.Printf("Circuit breaker for %s: %s -> %s after %d consecutive failures", service, c.state, state, c.failures)
+	} else {
+		log.Printf("Circuit breaker for %s: %s -> %s", service, c.state, state)
+	}
+	c.state = state
+	c.since = b.now()
+	c.probes = 0
+	if state == CircuitHalfOpen {
+		c.halfOpens++
+	}
+}
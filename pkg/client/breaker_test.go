@@ -0,0 +1,287 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubDoer answers every call with the current answer, without any network
+type stubDoer struct {
+	mu     sync.Mutex
+	calls  int
+	answer flakyAnswer
+}
+
+func (d *stubDoer) Do(req *http.Request) (*http.Response, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.calls++
+	if d.answer.reset {
+		return nil, errors.New("connection reset by peer")
+	}
+
+	recorder := httptest.NewRecorder()
+	recorder.Header().Set("Content-Type", d.answer.contentType)
+	recorder.WriteHeader(d.answer.status)
+	recorder.WriteString(d.answer.body)
+	return recorder.Result(), nil
+}
+
+func (d *stubDoer) set(answer flakyAnswer) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.answer = answer
+}
+
+func (d *stubDoer) count() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.calls
+}
+
+// fakeClock is a clock moved forward by the tests
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestBreaker(doer Doer, probes int) (*Breaker, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)}
+	breaker := NewBreaker(doer, 3, 10*time.Second, probes)
+	breaker.now = clock.Now
+	return breaker, clock
+}
+
+func breakerCall(breaker *Breaker) error {
+	req := httptest.NewRequest(http.MethodGet, "http://ondemand:10000/?name=whoami", nil)
+	resp, err := breaker.Do(req)
+	if err == nil {
+		resp.Body.Close()
+	}
+	return err
+}
+
+func TestBreaker_Do(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		answers       []flakyAnswer
+		expectedState string
+	}{
+		{
+			desc:          "successes keep the circuit closed",
+			answers:       []flakyAnswer{started, started, started, started},
+			expectedState: CircuitClosed,
+		},
+		{
+			desc:          "failures below the threshold keep the circuit closed",
+			answers:       []flakyAnswer{connectionReset, unavailable},
+			expectedState: CircuitClosed,
+		},
+		{
+			desc:          "a success resets the failures",
+			answers:       []flakyAnswer{connectionReset, unavailable, started, connectionReset, unavailable},
+			expectedState: CircuitClosed,
+		},
+		{
+			desc:          "consecutive failures open the circuit",
+			answers:       []flakyAnswer{connectionReset, unavailable, connectionReset},
+			expectedState: CircuitOpen,
+		},
+		{
+			desc:          "failed services and client errors are answers",
+			answers:       []flakyAnswer{serviceFailed, notFound, serviceFailed, notFound},
+			expectedState: CircuitClosed,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			doer := &stubDoer{}
+			breaker, _ := newTestBreaker(doer, 1)
+
+			for _, answer := range test.answers {
+				doer.set(answer)
+				breakerCall(breaker)
+			}
+
+			states := breaker.States()
+			require.Len(t, states, 1)
+			assert.Equal(t, "http://ondemand:10000", states[0].Service)
+			assert.Equal(t, test.expectedState, states[0].State)
+		})
+	}
+}
+
+func TestBreaker_OpenCircuit(t *testing.T) {
+	doer := &stubDoer{answer: unavailable}
+	breaker, clock := newTestBreaker(doer, 1)
+
+	for i := 0; i < 3; i++ {
+		breakerCall(breaker)
+	}
+	require.Equal(t, 3, doer.count())
+
+	// Open: fails fast without calling the ondemand service
+	err := breakerCall(breaker)
+	assert.True(t, errors.Is(err, ErrCircuitOpen))
+	assert.Equal(t, 3, doer.count())
+
+	// Half-open: a failed probe opens the circuit again
+	clock.Advance(10 * time.Second)
+	assert.NoError(t, breakerCall(breaker))
+	assert.Equal(t, 4, doer.count())
+	assert.Equal(t, CircuitOpen, breaker.States()[0].State)
+	assert.True(t, errors.Is(breakerCall(breaker), ErrCircuitOpen))
+
+	// Half-open: a successful probe closes the circuit
+	clock.Advance(10 * time.Second)
+	doer.set(started)
+	assert.NoError(t, breakerCall(breaker))
+	assert.Equal(t, CircuitClosed, breaker.States()[0].State)
+	assert.NoError(t, breakerCall(breaker))
+	assert.Equal(t, 6, doer.count())
+}
+
+func TestBreaker_HalfOpenProbes(t *testing.T) {
+	release := make(chan struct{})
+	var calls int
+	var mu sync.Mutex
+	doer := doerFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		<-release
+		return nil, errors.New("connection reset by peer")
+	})
+	breaker, clock := newTestBreaker(doer, 2)
+
+	breaker.circuits["http://ondemand:10000"] = &circuit{state: CircuitOpen, failures: 3, since: clock.Now()}
+	clock.Advance(10 * time.Second)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			breakerCall(breaker)
+		}()
+	}
+
+	// Both probe slots are taken, the third call fails fast
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls == 2
+	}, time.Second, time.Millisecond)
+	assert.True(t, errors.Is(breakerCall(breaker), ErrCircuitOpen))
+
+	close(release)
+	wg.Wait()
+	assert.Equal(t, CircuitOpen, breaker.States()[0].State)
+}
+
+func TestBreaker_LateRequest(t *testing.T) {
+	release := make(chan struct{})
+	var calls int
+	var mu sync.Mutex
+	doer := doerFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		switch req.URL.Query().Get("name") {
+		case "slow":
+			<-release
+		case "probe":
+			<-req.Context().Done()
+		}
+		return nil, errors.New("connection reset by peer")
+	})
+	breaker, clock := newTestBreaker(doer, 1)
+
+	// The slow request is let through while the circuit is closed
+	slow, cancelSlow := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		breaker.Do(httptest.NewRequest(http.MethodGet, "http://ondemand:10000/?name=slow", nil).WithContext(slow))
+	}()
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls == 1
+	}, time.Second, time.Millisecond)
+
+	breaker.mu.Lock()
+	breaker.circuits["http://ondemand:10000"] = &circuit{state: CircuitOpen, failures: 3, since: clock.Now()}
+	breaker.mu.Unlock()
+	clock.Advance(10 * time.Second)
+
+	// The probe takes the only slot of the half-open circuit
+	probe, cancelProbe := context.WithCancel(context.Background())
+	defer cancelProbe()
+	go breaker.Do(httptest.NewRequest(http.MethodGet, "http://ondemand:10000/?name=probe", nil).WithContext(probe))
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls == 2
+	}, time.Second, time.Millisecond)
+
+	// The slow request ends without freeing the slot of the probe
+	cancelSlow()
+	close(release)
+	<-done
+
+	assert.True(t, errors.Is(breakerCall(breaker), ErrCircuitOpen))
+	assert.Equal(t, CircuitHalfOpen, breaker.States()[0].State)
+}
+
+func TestBreaker_CancelledRequest(t *testing.T) {
+	doer := doerFunc(func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	})
+	breaker, _ := newTestBreaker(doer, 1)
+
+	for i := 0; i < 5; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		req := httptest.NewRequest(http.MethodGet, "http://ondemand:10000/?name=whoami", nil).WithContext(ctx)
+		_, err := breaker.Do(req)
+		assert.True(t, errors.Is(err, context.Canceled))
+	}
+
+	states := breaker.States()
+	require.Len(t, states, 1)
+	assert.Equal(t, CircuitClosed, states[0].State)
+	assert.Equal(t, 0, states[0].Failures)
+}
+
+type doerFunc func(req *http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
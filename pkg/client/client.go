@@ -0,0 +1,40 @@
+package client
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Doer sends HTTP requests, it is implemented by *http.Client and by the wrappers of this package
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Default is a custom client to timeout after 2 seconds if the service is not ready, used when no client is configured
+var Default = &http.Client{
+	Timeout: time.Second * 2,
+}
+
+// isServerFailure reports whether the ondemand service could not answer, as opposed to a deliberate answer.
+// Connection errors and server errors are failures, unless the answer carries a JSON document.
+func isServerFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	if resp.StatusCode < 500 || resp.StatusCode == http.StatusNotImplemented {
+		return false
+	}
+
+	return !isJSON(resp.Header.Get("Content-Type"))
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || (strings.HasPrefix(mediaType, "application/") && strings.HasSuffix(mediaType, "+json"))
+}
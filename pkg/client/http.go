@@ -0,0 +1,114 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Options of the HTTP client calling the ondemand service. Zero values are not the http.DefaultTransport ones:
+// zero durations mean no timeout, zero MaxIdleConns and MaxConnsPerHost mean no limit, and zero KeepAlive and
+// MaxIdleConnsPerHost keep the net.Dialer and http.Transport defaults, 15s and 2.
+type Options struct {
+	// Timeout of a whole request, including reading the body
+	Timeout time.Duration
+	// DialTimeout of a TCP connection
+	DialTimeout time.Duration
+	// KeepAlive period of the TCP connections
+	KeepAlive time.Duration
+	// TLSHandshakeTimeout of a TLS connection
+	TLSHandshakeTimeout time.Duration
+	// IdleConnTimeout closes the connections unused for this long
+	IdleConnTimeout     time.Duration
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	MaxConnsPerHost     int
+
+	// CAFile is a PEM bundle of the authorities trusted in addition to the system ones
+	CAFile string
+	// CertFile and KeyFile are the PEM client certificate and key presented for mTLS
+	CertFile string
+	KeyFile  string
+	// ServerName overrides the name verified in the certificate of the ondemand service
+	ServerName         string
+	InsecureSkipVerify bool
+
+	// Proxy is the URL of the proxy to the ondemand service, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+	// environment variables are used when empty
+	Proxy string
+}
+
+// NewHTTPClient creates a client with its own transport, so that its connections are not shared with other middlewares
+func NewHTTPClient(options Options) (*http.Client, error) {
+	tlsConfig, err := options.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if len(options.Proxy) != 0 {
+		proxyURL, err := url.Parse(options.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy: %w", err)
+		}
+		proxy = http.ProxyURL(proxyURL)
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   options.DialTimeout,
+		KeepAlive: options.KeepAlive,
+	}
+
+	return &http.Client{
+		Timeout: options.Timeout,
+		Transport: &http.Transport{
+			Proxy:               proxy,
+			DialContext:         dialer.DialContext,
+			TLSClientConfig:     tlsConfig,
+			TLSHandshakeTimeout: options.TLSHandshakeTimeout,
+			IdleConnTimeout:     options.IdleConnTimeout,
+			MaxIdleConns:        options.MaxIdleConns,
+			MaxIdleConnsPerHost: options.MaxIdleConnsPerHost,
+			MaxConnsPerHost:     options.MaxConnsPerHost,
+			ForceAttemptHTTP2:   true,
+		},
+	}, nil
+}
+
+func (options Options) tlsConfig() (*tls.Config, error) {
+	config := &tls.Config{
+		ServerName:         options.ServerName,
+		InsecureSkipVerify: options.InsecureSkipVerify,
+	}
+
+	if len(options.CAFile) != 0 {
+		pem, err := ioutil.ReadFile(options.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cafile: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("invalid cafile: no certificate found in %s", options.CAFile)
+		}
+		config.RootCAs = pool
+	}
+
+	if len(options.CertFile) != 0 || len(options.KeyFile) != 0 {
+		certificate, err := tls.LoadX509KeyPair(options.CertFile, options.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certfile or keyfile: %w", err)
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	return config, nil
+}
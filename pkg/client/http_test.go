@@ -0,0 +1,225 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPKI is a certificate authority with a server certificate for ondemand.internal and a client certificate
+type testPKI struct {
+	pool       *x509.CertPool
+	server     tls.Certificate
+	caFile     string
+	certFile   string
+	keyFile    string
+	serverName string
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	dir := t.TempDir()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ondemand test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	issue := func(serial int64, usage x509.ExtKeyUsage, dnsNames []string) ([]byte, *ecdsa.PrivateKey) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: fmt.Sprintf("ondemand test %d", serial)},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+			DNSNames:     dnsNames,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+		require.NoError(t, err)
+		return der, key
+	}
+
+	writePEM := func(name string, blockType string, bytes []byte) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes}), 0600))
+		return path
+	}
+
+	serverDER, serverKey := issue(2, x509.ExtKeyUsageServerAuth, []string{"ondemand.internal"})
+	clientDER, clientKey := issue(3, x509.ExtKeyUsageClientAuth, nil)
+	clientKeyDER, err := x509.MarshalECPrivateKey(clientKey)
+	require.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(caCert)
+
+	return &testPKI{
+		pool:       pool,
+		server:     tls.Certificate{Certificate: [][]byte{serverDER}, PrivateKey: serverKey},
+		caFile:     writePEM("ca.pem", "CERTIFICATE", caDER),
+		certFile:   writePEM("client.pem", "CERTIFICATE", clientDER),
+		keyFile:    writePEM("client-key.pem", "EC PRIVATE KEY", clientKeyDER),
+		serverName: "ondemand.internal",
+	}
+}
+
+func (pki *testPKI) newServer(clientAuth tls.ClientAuthType) *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "started")
+	}))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{pki.server},
+		ClientAuth:   clientAuth,
+		ClientCAs:    pki.pool,
+	}
+	server.StartTLS()
+	return server
+}
+
+func TestNewHTTPClient_TLS(t *testing.T) {
+	pki := newTestPKI(t)
+
+	testCases := []struct {
+		desc          string
+		clientAuth    tls.ClientAuthType
+		options       Options
+		expectedError bool
+	}{
+		{
+			desc:          "unknown authority",
+			clientAuth:    tls.NoClientCert,
+			options:       Options{ServerName: pki.serverName},
+			expectedError: true,
+		},
+		{
+			desc:       "custom ca bundle",
+			clientAuth: tls.NoClientCert,
+			options:    Options{CAFile: pki.caFile, ServerName: pki.serverName},
+		},
+		{
+			desc:          "server name mismatch",
+			clientAuth:    tls.NoClientCert,
+			options:       Options{CAFile: pki.caFile},
+			expectedError: true,
+		},
+		{
+			desc:          "mtls without client certificate",
+			clientAuth:    tls.RequireAndVerifyClientCert,
+			options:       Options{CAFile: pki.caFile, ServerName: pki.serverName},
+			expectedError: true,
+		},
+		{
+			desc:       "mtls with client certificate",
+			clientAuth: tls.RequireAndVerifyClientCert,
+			options:    Options{CAFile: pki.caFile, CertFile: pki.certFile, KeyFile: pki.keyFile, ServerName: pki.serverName},
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			server := pki.newServer(test.clientAuth)
+			defer server.Close()
+
+			test.options.Timeout = 2 * time.Second
+			httpClient, err := NewHTTPClient(test.options)
+			require.NoError(t, err)
+
+			resp, err := httpClient.Get(server.URL)
+
+			if test.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			body, err := ioutil.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Equal(t, "started", string(body))
+		})
+	}
+}
+
+func TestNewHTTPClient_InvalidOptions(t *testing.T) {
+	pki := newTestPKI(t)
+
+	testCases := []struct {
+		desc    string
+		options Options
+	}{
+		{
+			desc:    "missing ca file",
+			options: Options{CAFile: filepath.Join(t.TempDir(), "missing.pem")},
+		},
+		{
+			desc:    "ca file without certificate",
+			options: Options{CAFile: pki.keyFile},
+		},
+		{
+			desc:    "certificate without key",
+			options: Options{CertFile: pki.certFile},
+		},
+		{
+			desc:    "invalid proxy",
+			options: Options{Proxy: "http://proxy:port"},
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewHTTPClient(test.options)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewHTTPClient_Proxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		fmt.Fprint(w, "started")
+	}))
+	defer proxy.Close()
+
+	httpClient, err := NewHTTPClient(Options{Timeout: 2 * time.Second, Proxy: proxy.URL})
+	require.NoError(t, err)
+
+	resp, err := httpClient.Get("http://ondemand.internal:10000/?name=whoami")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, "http://ondemand.internal:10000/?name=whoami", proxied)
+}
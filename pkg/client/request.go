@@ -0,0 +1,94 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// NamePlaceholder is replaced by the escaped service name in a path template
+	NamePlaceholder = "{name}"
+	// TimeoutPlaceholder is replaced by the session timeout in a path template
+	TimeoutPlaceholder = "{timeout}"
+)
+
+var placeholder = regexp.MustCompile(`\{[^/{}]*\}`)
+
+// RequestBuilder builds the URLs of the calls to the ondemand service.
+// The path and query of the service URL are preserved, the path template is appended to the path
+// and the parameters which are not part of the template are added to the query.
+type RequestBuilder struct {
+	base         *url.URL
+	pathTemplate string
+}
+
+// NewRequestBuilder validates the service URL and the path template, e.g. /api/v2/services/{name}/wake
+func NewRequestBuilder(serviceURL string, pathTemplate string) (*RequestBuilder, error) {
+	base, err := url.Parse(serviceURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid serviceurl %q: %w", serviceURL, err)
+	}
+
+	if base.Scheme != "http" && base.Scheme != "https" {
+		return nil, fmt.Errorf("invalid serviceurl %q: scheme must be http or https", serviceURL)
+	}
+
+	if len(base.Host) == 0 {
+		return nil, fmt.Errorf("invalid serviceurl %q: missing host", serviceURL)
+	}
+
+	if len(base.Fragment) != 0 {
+		return nil, fmt.Errorf("invalid serviceurl %q: fragments are not sent to the server", serviceURL)
+	}
+
+	if len(pathTemplate) != 0 {
+		if !strings.HasPrefix(pathTemplate, "/") {
+			return nil, fmt.Errorf("invalid path template %q: must start with /", pathTemplate)
+		}
+
+		for _, match := range placeholder.FindAllString(pathTemplate, -1) {
+			if match != NamePlaceholder && match != TimeoutPlaceholder {
+				return nil, fmt.Errorf("invalid path template %q: unknown placeholder %s, must be %s or %s", pathTemplate, match, NamePlaceholder, TimeoutPlaceholder)
+			}
+		}
+
+		if strings.ContainsAny(placeholder.ReplaceAllString(pathTemplate, ""), "{}?#") {
+			return nil, fmt.Errorf("invalid path template %q: only a path with placeholders is allowed", pathTemplate)
+		}
+	}
+
+	return &RequestBuilder{base: base, pathTemplate: pathTemplate}, nil
+}
+
+// Build returns the URL of the call for the service
+func (b *RequestBuilder) Build(name string, timeout time.Duration) string {
+	u := *b.base
+	query := u.Query()
+
+	if strings.Contains(b.pathTemplate, NamePlaceholder) {
+		query.Del("name")
+	} else {
+		query.Set("name", name)
+	}
+
+	if strings.Contains(b.pathTemplate, TimeoutPlaceholder) {
+		query.Del("timeout")
+	} else {
+		query.Set("timeout", timeout.String())
+	}
+
+	if len(b.pathTemplate) != 0 {
+		replacer := strings.NewReplacer(NamePlaceholder, url.PathEscape(name), TimeoutPlaceholder, url.PathEscape(timeout.String()))
+		escapedPath := strings.TrimSuffix(b.base.EscapedPath(), "/") + replacer.Replace(b.pathTemplate)
+
+		// The template was validated, the only escaped parts come from the service URL and the placeholders
+		u.Path, _ = url.PathUnescape(escapedPath)
+		u.RawPath = escapedPath
+	}
+
+	u.RawQuery = query.Encode()
+	return u.String()
+}
@@ -0,0 +1,151 @@
+package client
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestBuilder_Build(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		serviceURL   string
+		pathTemplate string
+		name         string
+		expected     string
+	}{
+		{
+			desc:       "service url without path",
+			serviceURL: "http://ondemand:10000",
+			name:       "whoami",
+			expected:   "http://ondemand:10000?name=whoami&timeout=1m0s",
+		},
+		{
+			desc:       "name with reserved characters",
+			serviceURL: "http://ondemand:10000",
+			name:       "who&am i#1",
+			expected:   "http://ondemand:10000?name=who%26am+i%231&timeout=1m0s",
+		},
+		{
+			desc:       "service url with path and query",
+			serviceURL: "https://gateway/ondemand/api?token=abc",
+			name:       "whoami",
+			expected:   "https://gateway/ondemand/api?name=whoami&timeout=1m0s&token=abc",
+		},
+		{
+			desc:       "parameters of the service url are overridden",
+			serviceURL: "http://ondemand:10000/?name=db&timeout=1h",
+			name:       "whoami",
+			expected:   "http://ondemand:10000/?name=whoami&timeout=1m0s",
+		},
+		{
+			desc:         "path template",
+			serviceURL:   "http://ondemand:10000",
+			pathTemplate: "/api/v2/services/{name}/wake",
+			name:         "whoami",
+			expected:     "http://ondemand:10000/api/v2/services/whoami/wake?timeout=1m0s",
+		},
+		{
+			desc:         "path template with escaped name",
+			serviceURL:   "http://ondemand:10000",
+			pathTemplate: "/api/v2/services/{name}/wake",
+			name:         "stack/who am?i",
+			expected:     "http://ondemand:10000/api/v2/services/stack%2Fwho%20am%3Fi/wake?timeout=1m0s",
+		},
+		{
+			desc:         "path template appended to the service url path",
+			serviceURL:   "http://gateway/ondemand/?region=eu",
+			pathTemplate: "/services/{name}/wake/{timeout}",
+			name:         "whoami",
+			expected:     "http://gateway/ondemand/services/whoami/wake/1m0s?region=eu",
+		},
+		{
+			desc:         "path template without placeholder",
+			serviceURL:   "http://ondemand:10000",
+			pathTemplate: "/wake",
+			name:         "whoami",
+			expected:     "http://ondemand:10000/wake?name=whoami&timeout=1m0s",
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			builder, err := NewRequestBuilder(test.serviceURL, test.pathTemplate)
+			require.NoError(t, err)
+
+			request := builder.Build(test.name, time.Minute)
+			assert.Equal(t, test.expected, request)
+
+			parsed, err := url.Parse(request)
+			require.NoError(t, err)
+			if len(test.pathTemplate) == 0 {
+				assert.Equal(t, test.name, parsed.Query().Get("name"))
+			}
+		})
+	}
+}
+
+func TestNewRequestBuilder_Invalid(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		serviceURL    string
+		pathTemplate  string
+		expectedError string
+	}{
+		{
+			desc:          "unparsable url",
+			serviceURL:    "http://ondemand:port",
+			expectedError: "invalid serviceurl",
+		},
+		{
+			desc:          "missing scheme",
+			serviceURL:    "ondemand:10000",
+			expectedError: "scheme must be http or https",
+		},
+		{
+			desc:          "missing host",
+			serviceURL:    "http:///api",
+			expectedError: "missing host",
+		},
+		{
+			desc:          "fragment",
+			serviceURL:    "http://ondemand:10000/#wake",
+			expectedError: "fragments are not sent",
+		},
+		{
+			desc:          "relative path template",
+			serviceURL:    "http://ondemand:10000",
+			pathTemplate:  "services/{name}",
+			expectedError: "must start with /",
+		},
+		{
+			desc:          "unknown placeholder",
+			serviceURL:    "http://ondemand:10000",
+			pathTemplate:  "/services/{service}",
+			expectedError: "unknown placeholder {service}",
+		},
+		{
+			desc:          "query in path template",
+			serviceURL:    "http://ondemand:10000",
+			pathTemplate:  "/services/{name}?wake=true",
+			expectedError: "only a path with placeholders",
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewRequestBuilder(test.serviceURL, test.pathTemplate)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.expectedError)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package client
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Retry retries idempotent requests failing with a connection error or an unexpected server error,
+// waiting an exponential backoff with full jitter between attempts.
+// Answers carrying a JSON document are deliberate and never retried.
+type Retry struct {
+	Client Doer
+	// Attempts is the maximum number of attempts, including the first one
+	Attempts int
+	// InitialBackoff is the maximum wait before the first retry, doubled for each following retry
+	InitialBackoff time.Duration
+	// MaxBackoff bounds the wait between two attempts
+	MaxBackoff time.Duration
+	// Budget limits the retries to a share of the requests, nil means unlimited
+	Budget *Budget
+
+	mu     sync.Mutex
+	random *rand.Rand
+}
+
+// Do send the request, retrying it when allowed
+func (r *Retry) Do(req *http.Request) (*http.Response, error) {
+	r.Budget.deposit()
+
+	for attempt := 1; ; attempt++ {
+		resp, err := r.Client.Do(req)
+
+		if attempt >= r.Attempts || !isIdempotent(req) || !isRetryable(req.Context(), resp, err) || !r.Budget.withdraw() {
+			return resp, err
+		}
+
+		if resp != nil {
+			// Drain the body so that the connection can be reused
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(r.backoff(attempt)):
+		}
+	}
+}
+
+// backoff returns a random duration up to InitialBackoff * 2^(attempt-1), bounded by MaxBackoff
+func (r *Retry) backoff(attempt int) time.Duration {
+	backoff := r.InitialBackoff
+	for i := 1; i < attempt && backoff < r.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if r.MaxBackoff > 0 && backoff > r.MaxBackoff {
+		backoff = r.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.random == nil {
+		r.random = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return time.Duration(r.random.Int63n(int64(backoff) + 1))
+}
+
+func isIdempotent(req *http.Request) bool {
+	return req.Method == http.MethodGet || req.Method == http.MethodHead || req.Method == http.MethodOptions
+}
+
+func isRetryable(ctx context.Context, resp *http.Response, err error) bool {
+	// The caller gave up, retrying is pointless
+	if err != nil && ctx.Err() != nil {
+		return false
+	}
+	return isServerFailure(resp, err)
+}
+
+// Budget is a token bucket limiting retries to a share of the requests.
+// Every request earns Ratio token and every retry spends one, up to Max tokens saved.
+type Budget struct {
+	Ratio float64
+	Max   float64
+
+	mu     sync.Mutex
+	tokens float64
+}
+
+// NewBudget creates a full budget
+func NewBudget(ratio float64, max float64) *Budget {
+	return &Budget{Ratio: ratio, Max: max, tokens: max}
+}
+
+func (b *Budget) deposit() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens += b.Ratio
+	if b.tokens > b.Max {
+		b.tokens = b.Max
+	}
+}
+
+func (b *Budget) withdraw() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
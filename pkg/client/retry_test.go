@@ -0,0 +1,214 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyAnswer is the behavior of the mock ondemand service for one call
+type flakyAnswer struct {
+	reset       bool
+	status      int
+	contentType string
+	body        string
+}
+
+var (
+	connectionReset = flakyAnswer{reset: true}
+	unavailable     = flakyAnswer{status: http.StatusServiceUnavailable, contentType: "text/plain", body: "upstream unavailable"}
+	started         = flakyAnswer{status: http.StatusOK, contentType: "text/plain", body: "started"}
+	serviceFailed   = flakyAnswer{status: http.StatusInternalServerError, contentType: "application/json", body: `{"version":1,"state":"failed","message":"image not found"}`}
+	notFound        = flakyAnswer{status: http.StatusNotFound, contentType: "text/plain", body: "unknown service"}
+)
+
+// newFlakyServer answers each call with the next answer, the last one being repeated
+func newFlakyServer(answers []flakyAnswer, calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(atomic.AddInt32(calls, 1)) - 1
+		if call >= len(answers) {
+			call = len(answers) - 1
+		}
+		answer := answers[call]
+
+		if answer.reset {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err == nil {
+				conn.Close()
+			}
+			return
+		}
+
+		w.Header().Set("Content-Type", answer.contentType)
+		w.WriteHeader(answer.status)
+		fmt.Fprint(w, answer.body)
+	}))
+}
+
+func TestRetry_Do(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		method         string
+		answers        []flakyAnswer
+		attempts       int
+		budget         *Budget
+		expectedCalls  int32
+		expectedStatus int
+		expectedError  bool
+	}{
+		{
+			desc:           "success is not retried",
+			method:         http.MethodGet,
+			answers:        []flakyAnswer{started},
+			attempts:       3,
+			expectedCalls:  1,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "connection reset is retried",
+			method:         http.MethodGet,
+			answers:        []flakyAnswer{connectionReset, connectionReset, started},
+			attempts:       3,
+			expectedCalls:  3,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "server error is retried",
+			method:         http.MethodGet,
+			answers:        []flakyAnswer{unavailable, started},
+			attempts:       3,
+			expectedCalls:  2,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			desc:           "attempts are exhausted",
+			method:         http.MethodGet,
+			answers:        []flakyAnswer{unavailable},
+			attempts:       3,
+			expectedCalls:  3,
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			desc:          "connection errors are returned once attempts are exhausted",
+			method:        http.MethodGet,
+			answers:       []flakyAnswer{connectionReset},
+			attempts:      2,
+			expectedCalls: 2,
+			expectedError: true,
+		},
+		{
+			desc:           "deliberate service failure is not retried",
+			method:         http.MethodGet,
+			answers:        []flakyAnswer{serviceFailed, started},
+			attempts:       3,
+			expectedCalls:  1,
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			desc:           "client error is not retried",
+			method:         http.MethodGet,
+			answers:        []flakyAnswer{notFound, started},
+			attempts:       3,
+			expectedCalls:  1,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			desc:           "non idempotent request is not retried",
+			method:         http.MethodPost,
+			answers:        []flakyAnswer{unavailable, started},
+			attempts:       3,
+			expectedCalls:  1,
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			desc:           "exhausted budget prevents retries",
+			method:         http.MethodGet,
+			answers:        []flakyAnswer{unavailable, started},
+			attempts:       3,
+			budget:         NewBudget(0.1, 0),
+			expectedCalls:  1,
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			desc:           "budget allows retries",
+			method:         http.MethodGet,
+			answers:        []flakyAnswer{unavailable, unavailable, started},
+			attempts:       3,
+			budget:         NewBudget(0.1, 2),
+			expectedCalls:  3,
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+			mockServer := newFlakyServer(test.answers, &calls)
+			defer mockServer.Close()
+
+			retry := &Retry{
+				Client:         &http.Client{Timeout: time.Second},
+				Attempts:       test.attempts,
+				InitialBackoff: time.Millisecond,
+				MaxBackoff:     10 * time.Millisecond,
+				Budget:         test.budget,
+			}
+
+			req, err := http.NewRequest(test.method, mockServer.URL, nil)
+			require.NoError(t, err)
+
+			resp, err := retry.Do(req)
+
+			if test.expectedError {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				defer resp.Body.Close()
+				assert.Equal(t, test.expectedStatus, resp.StatusCode)
+			}
+			assert.Equal(t, test.expectedCalls, atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+func TestRetry_Backoff(t *testing.T) {
+	retry := &Retry{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for attempt, bound := range map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		3: 400 * time.Millisecond,
+		4: 800 * time.Millisecond,
+		5: time.Second,
+		9: time.Second,
+	} {
+		for i := 0; i < 100; i++ {
+			backoff := retry.backoff(attempt)
+			assert.GreaterOrEqual(t, int64(backoff), int64(0))
+			assert.LessOrEqual(t, int64(backoff), int64(bound))
+		}
+	}
+}
+
+func TestBudget(t *testing.T) {
+	budget := NewBudget(0.5, 2)
+
+	assert.True(t, budget.withdraw())
+	assert.True(t, budget.withdraw())
+	assert.False(t, budget.withdraw())
+
+	budget.deposit()
+	assert.False(t, budget.withdraw())
+
+	budget.deposit()
+	assert.True(t, budget.withdraw())
+}
@@ -0,0 +1,43 @@
+package endpoints
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/pages"
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/strategy"
+)
+
+// assetsPrefix is the reserved endpoint path the assets are served below
+const assetsPrefix = "assets/"
+
+// Assets serves the favicon, stylesheet and scripts of the built-in pages, so that they load no external resource
+type Assets struct {
+	// MaxAge is how long the browsers may use an asset before checking it again with its ETag
+	MaxAge time.Duration
+}
+
+// ServeHTTP serve the asset
+func (e *Assets) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		rw.Header().Set("Allow", "GET, HEAD")
+		http.Error(rw, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	endpoint, _ := strategy.ReservedEndpoint(req.URL.Path)
+	asset, ok := pages.GetAsset(strings.TrimPrefix(endpoint, assetsPrefix))
+	if !ok {
+		http.NotFound(rw, req)
+		return
+	}
+
+	rw.Header().Set("Content-Type", asset.ContentType)
+	rw.Header().Set("Cache-Control", "public, max-age="+strconv.FormatInt(int64(e.MaxAge/time.Second), 10))
+	rw.Header().Set("ETag", asset.ETag)
+
+	// ServeContent answers 304 to the conditional requests matching the ETag, and omits the body of HEAD requests
+	http.ServeContent(rw, req, endpoint, time.Time{}, strings.NewReader(asset.Content))
+}
@@ -0,0 +1,94 @@
+package endpoints
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/pages"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssets_ServeHTTP(t *testing.T) {
+	stylesheet, ok := pages.GetAsset("ondemand.css")
+	require.True(t, ok)
+
+	testCases := []struct {
+		desc                string
+		method              string
+		path                string
+		ifNoneMatch         string
+		expectedCode        int
+		expectedContentType string
+		expectedBody        string
+	}{
+		{
+			desc:                "stylesheet",
+			method:              http.MethodGet,
+			path:                "/app/__ondemand/assets/ondemand.css",
+			expectedCode:        http.StatusOK,
+			expectedContentType: "text/css; charset=utf-8",
+			expectedBody:        stylesheet.Content,
+		},
+		{
+			desc:                "favicon",
+			method:              http.MethodHead,
+			path:                "/__ondemand/assets/favicon.svg",
+			expectedCode:        http.StatusOK,
+			expectedContentType: "image/svg+xml",
+		},
+		{
+			desc:                "font",
+			method:              http.MethodHead,
+			path:                "/__ondemand/assets/open-sans-600.woff2",
+			expectedCode:        http.StatusOK,
+			expectedContentType: "font/woff2",
+		},
+		{
+			desc:         "not modified",
+			method:       http.MethodGet,
+			path:         "/__ondemand/assets/ondemand.css",
+			ifNoneMatch:  stylesheet.ETag,
+			expectedCode: http.StatusNotModified,
+		},
+		{
+			desc:         "unknown asset",
+			method:       http.MethodGet,
+			path:         "/__ondemand/assets/missing.js",
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			desc:         "method not allowed",
+			method:       http.MethodPost,
+			path:         "/__ondemand/assets/ondemand.css",
+			expectedCode: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			endpoints := &Endpoints{Assets: &Assets{MaxAge: time.Hour}}
+
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(test.method, "http://mydomain"+test.path, nil)
+			if len(test.ifNoneMatch) != 0 {
+				req.Header.Set("If-None-Match", test.ifNoneMatch)
+			}
+
+			endpoints.ServeHTTP(recorder, req)
+
+			assert.Equal(t, test.expectedCode, recorder.Code)
+			if test.expectedCode == http.StatusOK {
+				assert.Equal(t, test.expectedContentType, recorder.Header().Get("Content-Type"))
+				assert.Equal(t, "public, max-age=3600", recorder.Header().Get("Cache-Control"))
+				assert.NotEmpty(t, recorder.Header().Get("ETag"))
+				assert.Equal(t, test.expectedBody, recorder.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package endpoints
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/client"
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/strategy"
+)
+
+// Endpoints serves the reserved endpoints of the middleware instead of forwarding to the service
+type Endpoints struct {
+	Events http.Handler
+	Status http.Handler
+	Assets http.Handler
+}
+
+// ServeHTTP dispatch the request to the reserved endpoint
+func (e *Endpoints) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	endpoint, _ := strategy.ReservedEndpoint(req.URL.Path)
+
+	switch {
+	case endpoint == "events":
+		e.Events.ServeHTTP(rw, req)
+	case endpoint == "status":
+		e.Status.ServeHTTP(rw, req)
+	case strings.HasPrefix(endpoint, assetsPrefix):
+		e.Assets.ServeHTTP(rw, req)
+	default:
+		http.NotFound(rw, req)
+	}
+}
+
+// GroupDocument is the JSON representation of the status of a group
+type GroupDocument struct {
+	Name     string                `json:"name"`
+	State    string                `json:"state"`
+	Services []ServiceDocument     `json:"services"`
+	Circuits []client.CircuitState `json:"circuits,omitempty"`
+}
+
+// ServiceDocument is the JSON representation of the status of a service of the group
+type ServiceDocument struct {
+	Name      string                  `json:"name"`
+	State     string                  `json:"state"`
+	CheckedAt time.Time               `json:"checkedAt"`
+	Status    *strategy.ServiceStatus `json:"status,omitempty"`
+	Error     string                  `json:"error,omitempty"`
+}
+
+func newGroupDocument(name string, group strategy.GroupStatus) GroupDocument {
+	document := GroupDocument{
+		Name:     name,
+		State:    group.State(),
+		Services: make([]ServiceDocument, 0, len(group.Results)),
+	}
+
+	for _, result := range group.Results {
+		service := ServiceDocument{
+			Name:      result.Service.Name,
+			State:     result.State(),
+			CheckedAt: result.CheckedAt,
+			Status:    result.Status,
+		}
+		if result.Err != nil {
+			service.Error = result.Err.Error()
+		}
+		document.Services = append(document.Services, service)
+	}
+
+	return document
+}
+
+func (d GroupDocument) withoutCheckTimes() GroupDocument {
+	services := make([]ServiceDocument, len(d.Services))
+	for index, service := range d.Services {
+		service.CheckedAt = time.Time{}
+		services[index] = service
+	}
+	d.Services = services
+	return d
+}
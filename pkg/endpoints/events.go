@@ -0,0 +1,103 @@
+package endpoints
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/strategy"
+)
+
+// Events streams the status transitions of the group as Server-Sent Events.
+// A "status" event is sent whenever the group status changes, followed by a "ready" or "failed" event
+// closing the stream once the group is started or failed.
+type Events struct {
+	Group strategy.GroupPoller
+	Name  string
+	// Interval between two status checks
+	Interval time.Duration
+	// Heartbeat is the maximum time without writing to the stream
+	Heartbeat time.Duration
+	// MaxDuration closes the stream, the browser reconnects by itself
+	MaxDuration time.Duration
+}
+
+// ServeHTTP stream the group status
+func (e *Events) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		http.Error(rw, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), e.MaxDuration)
+	defer cancel()
+
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.Header().Set("X-Accel-Buffering", "no")
+	rw.WriteHeader(http.StatusOK)
+	fmt.Fprintf(rw, "retry: %d\n\n", e.Interval.Milliseconds())
+	flusher.Flush()
+
+	ticker := time.NewTicker(e.Interval)
+	defer ticker.Stop()
+
+	var last []byte
+	lastWrite := time.Now()
+
+	for {
+		group := e.Group.Poll(ctx)
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		document := newGroupDocument(e.Name, group)
+		data, err := json.Marshal(document)
+		if err != nil {
+			return
+		}
+
+		// Only a change of the services status is a transition, not a new check time
+		transition, err := json.Marshal(document.withoutCheckTimes())
+		if err != nil {
+			return
+		}
+
+		if !bytes.Equal(transition, last) {
+			writeEvent(rw, "status", data)
+			last = transition
+			lastWrite = time.Now()
+		} else if time.Since(lastWrite) >= e.Heartbeat {
+			fmt.Fprint(rw, ": heartbeat\n\n")
+			lastWrite = time.Now()
+		}
+
+		switch group.State() {
+		case strategy.StateStarted:
+			writeEvent(rw, "ready", data)
+			flusher.Flush()
+			return
+		case strategy.StateFailed:
+			writeEvent(rw, "failed", data)
+			flusher.Flush()
+			return
+		}
+
+		flusher.Flush()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func writeEvent(rw http.ResponseWriter, event string, data []byte) {
+	fmt.Fprintf(rw, "event: %s\ndata: %s\n\n", event, data)
+}
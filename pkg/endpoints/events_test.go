@@ -0,0 +1,120 @@
+package endpoints
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/strategy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvents_ServeHTTP(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		bodies         []string
+		expectedEvents []string
+	}{
+		{
+			desc:           "service becomes ready",
+			bodies:         []string{"starting", "starting", "started"},
+			expectedEvents: []string{"status", "status", "ready"},
+		},
+		{
+			desc:           "service already started",
+			bodies:         []string{"started"},
+			expectedEvents: []string{"status", "ready"},
+		},
+		{
+			desc:           "service fails",
+			bodies:         []string{"starting", "exploded"},
+			expectedEvents: []string{"status", "status", "failed"},
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				call := int(atomic.AddInt32(&calls, 1)) - 1
+				if call >= len(test.bodies) {
+					call = len(test.bodies) - 1
+				}
+				fmt.Fprint(w, test.bodies[call])
+			}))
+			defer mockServer.Close()
+
+			events := &Events{
+				Group:       strategy.GroupPoller{Services: []strategy.Service{{Name: "whoami", Request: mockServer.URL}}},
+				Name:        "whoami",
+				Interval:    10 * time.Millisecond,
+				Heartbeat:   time.Second,
+				MaxDuration: 5 * time.Second,
+			}
+
+			server := httptest.NewServer(events)
+			defer server.Close()
+
+			resp, err := http.Get(server.URL + "/whoami/__ondemand/events")
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+			body, err := ioutil.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedEvents, parseEventNames(string(body)))
+			assert.Contains(t, string(body), `"name":"whoami"`)
+		})
+	}
+}
+
+func TestEvents_StopsAfterMaxDuration(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "starting")
+	}))
+	defer mockServer.Close()
+
+	events := &Events{
+		Group:       strategy.GroupPoller{Services: []strategy.Service{{Name: "whoami", Request: mockServer.URL}}},
+		Name:        "whoami",
+		Interval:    10 * time.Millisecond,
+		Heartbeat:   20 * time.Millisecond,
+		MaxDuration: 200 * time.Millisecond,
+	}
+
+	server := httptest.NewServer(events)
+	defer server.Close()
+
+	start := time.Now()
+	resp, err := http.Get(server.URL + "/__ondemand/events")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+	assert.Equal(t, []string{"status"}, parseEventNames(string(body)))
+	assert.Contains(t, string(body), ": heartbeat")
+}
+
+func parseEventNames(stream string) []string {
+	var names []string
+	for _, line := range strings.Split(stream, "\n") {
+		if strings.HasPrefix(line, "event: ") {
+			names = append(names, strings.TrimPrefix(line, "event: "))
+		}
+	}
+	return names
+}
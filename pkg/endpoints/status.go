@@ -0,0 +1,38 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/client"
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/strategy"
+)
+
+// Status answers the JSON status of every service of the group
+type Status struct {
+	Group strategy.GroupPoller
+	Name  string
+	// Breaker exposes the circuits of the ondemand services, nil when disabled
+	Breaker *client.Breaker
+}
+
+// ServeHTTP retrieve the group status
+func (e *Status) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		rw.Header().Set("Allow", "GET, HEAD")
+		http.Error(rw, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	group := e.Group.Poll(req.Context())
+
+	document := newGroupDocument(e.Name, group)
+	if e.Breaker != nil {
+		document.Circuits = e.Breaker.States()
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.Header().Set("Cache-Control", "no-store")
+	rw.WriteHeader(http.StatusOK)
+	json.NewEncoder(rw).Encode(document)
+}
@@ -0,0 +1,135 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/client"
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/strategy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatus_ServeHTTP(t *testing.T) {
+	testCases := []struct {
+		desc             string
+		method           string
+		bodies           []string
+		expectedCode     int
+		expectedState    string
+		expectedServices []string
+	}{
+		{
+			desc:             "all started",
+			method:           http.MethodGet,
+			bodies:           []string{"started", "started"},
+			expectedCode:     http.StatusOK,
+			expectedState:    strategy.StateStarted,
+			expectedServices: []string{strategy.StateStarted, strategy.StateStarted},
+		},
+		{
+			desc:             "one starting",
+			method:           http.MethodGet,
+			bodies:           []string{"started", "starting"},
+			expectedCode:     http.StatusOK,
+			expectedState:    strategy.StateStarting,
+			expectedServices: []string{strategy.StateStarted, strategy.StateStarting},
+		},
+		{
+			desc:             "one failed",
+			method:           http.MethodGet,
+			bodies:           []string{"exploded", "starting"},
+			expectedCode:     http.StatusOK,
+			expectedState:    strategy.StateFailed,
+			expectedServices: []string{strategy.StateFailed, strategy.StateStarting},
+		},
+		{
+			desc:         "method not allowed",
+			method:       http.MethodPost,
+			bodies:       []string{"started"},
+			expectedCode: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			services := make([]strategy.Service, len(test.bodies))
+			for index, body := range test.bodies {
+				body := body
+				mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					fmt.Fprint(w, body)
+				}))
+				defer mockServer.Close()
+
+				services[index] = strategy.Service{Name: fmt.Sprintf("whoami-%d", index), Request: mockServer.URL}
+			}
+
+			status := &Status{
+				Group: strategy.GroupPoller{Services: services},
+				Name:  "whoami",
+			}
+
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(test.method, "http://mydomain/__ondemand/status", nil)
+
+			status.ServeHTTP(recorder, req)
+
+			assert.Equal(t, test.expectedCode, recorder.Code)
+			if test.expectedCode != http.StatusOK {
+				return
+			}
+
+			assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+
+			var document GroupDocument
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &document))
+
+			assert.Equal(t, "whoami", document.Name)
+			assert.Equal(t, test.expectedState, document.State)
+			require.Len(t, document.Services, len(test.expectedServices))
+			for index, service := range document.Services {
+				assert.Equal(t, fmt.Sprintf("whoami-%d", index), service.Name)
+				assert.Equal(t, test.expectedServices[index], service.State)
+				assert.False(t, service.CheckedAt.IsZero())
+				assert.Equal(t, service.State == strategy.StateFailed, len(service.Error) != 0)
+			}
+		})
+	}
+}
+
+func TestStatus_ServeHTTP_Circuits(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "upstream unavailable", http.StatusServiceUnavailable)
+	}))
+	defer mockServer.Close()
+
+	breaker := client.NewBreaker(client.Default, 1, time.Minute, 1)
+	cache := strategy.NewStatusCache(0, 0)
+	cache.Client = breaker
+
+	status := &Status{
+		Group:   strategy.GroupPoller{Services: []strategy.Service{{Name: "whoami", Request: mockServer.URL}}, Cache: cache},
+		Name:    "whoami",
+		Breaker: breaker,
+	}
+
+	var document GroupDocument
+	for i := 0; i < 2; i++ {
+		recorder := httptest.NewRecorder()
+		status.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://mydomain/__ondemand/status", nil))
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &document))
+	}
+
+	assert.Equal(t, strategy.StateFailed, document.State)
+	assert.Contains(t, document.Services[0].Error, client.ErrCircuitOpen.Error())
+	require.Len(t, document.Circuits, 1)
+	assert.Equal(t, mockServer.URL, document.Circuits[0].Service)
+	assert.Equal(t, client.CircuitOpen, document.Circuits[0].State)
+}
@@ -0,0 +1,262 @@
+package pages
+
+// The pages and their assets are written in the files of this directory and copied into the Go strings by generate.go
+//go:generate go run generate.go
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+var faviconAsset = `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 64 64">
+  <rect width="64" height="64" rx="14" fill="#0046da"/>
+  <path d="M22 20a17 17 0 1 0 20 0" fill="none" stroke="#f6ecdf" stroke-width="6" stroke-linecap="round"/>
+  <path d="M32 12v20" fill="none" stroke="#ffcc01" stroke-width="6" stroke-linecap="round"/>
+</svg>`
+
+var stylesheetAsset = `@font-face {
+  font-family: 'Open Sans';
+  font-style: normal;
+  font-weight: 600;
+  font-display: swap;
+  src: local('Open Sans SemiBold'), local('OpenSans-SemiBold'), url('open-sans-600.woff2') format('woff2');
+}
+:root {
+  --color-Rose: #ff99a5;
+  --color-Jaune: #ffcc01;
+  --color-Vert: #00cc99;
+  --color-Raven: #0046da;
+  --color-Raven-shadow: #0046da66;
+  --color-Beige: #f6ecdf;
+  --color-Wayne6: #001440;
+  --translate-size: 7px;
+}
+html {
+  background-color: var(--color-Wayne6);
+}
+body {
+  height: 100vh;
+  width: 100vw;
+  font-family: 'Open Sans', sans-serif;
+  margin: 0;
+  padding: 0;
+}
+.u-flex-center {
+  display: flex;
+  justify-content: center;
+  align-items: center;
+}
+.cluster {
+  width: fit-content;
+  margin: auto;
+  margin-top: 30px;
+  margin-bottom: 20px;
+  border-radius: 24px;
+  padding: 24px 46px 1px;
+  background-color: var(--color-Beige);
+  position: relative;
+  transition: 300ms ease-in-out;
+  min-width: 200px;
+}
+.cluster:before {
+  content: '';
+  background-color: var(--color-Vert);
+  border-top-left-radius: 24px;
+  border-bottom-left-radius: 24px;
+  position: absolute;
+  bottom: 0;
+  left: 0;
+  top: 0;
+  width: 20px;
+}
+.cluster:after {
+  content: '';
+  background-color: var(--color-Rose);
+  border-top-right-radius: 24px;
+  border-bottom-right-radius: 24px;
+  position: absolute;
+  bottom: 0;
+  right: 0;
+  top: 0;
+  width: 20px;
+}
+.cluster:hover {
+  transform: translateY(calc(-1 * var(--translate-size)));
+  box-shadow: 0 15px 0 0 var(--color-Raven);
+}
+.title {
+  margin-top: 8px;
+  margin-bottom: 24px;
+  font-weight: 600;
+  font-size: 22px;
+}
+.title.small {
+  font-size: 14px;
+}
+.subtitle {
+  font-weight: 600;
+  font-size: 18px;
+  position: relative;
+}
+.subtitle:after {
+  background-color: var(--color-Jaune);
+  height: 5px;
+  bottom: -3px;
+  content: '';
+  left: 0;
+  position: absolute;
+  right: 0;
+  transform: scaleX(0);
+  transform-origin: 100% 50%;
+  transition: transform 300ms ease-in-out;
+}
+.cluster:hover .subtitle:after {
+  transform: scaleX(1);
+  transform-origin: 0 50%;
+}
+.footer {
+  position: absolute;
+  bottom: 0px;
+}
+.footer>a {
+  text-decoration: none;
+  color: var(--color-Beige);
+  transition: 500ms;
+  opacity: .4;
+}
+.footer>a:hover {
+  opacity: 1;
+}
+.copyright {
+  opacity: .3;
+  position: fixed;
+  bottom: 15px;
+  right: 120px;
+  color: var(--color-Beige);
+  transition-duration: 250ms;
+  transform: scale(0.7);
+}
+.copyright:hover {
+  opacity: 1;
+  transform: scale(1);
+}
+.copyright:before,
+.copyright:after {
+  opacity: 0;
+  position: absolute;
+  transition-duration: 250ms;
+  white-space: nowrap;
+}
+.copyright:before {
+  content: "Designed with";
+  left: -40px;
+}
+.copyright:after {
+  content: "by Staylix";
+  right: -35px;
+}
+.copyright:hover:before,
+.copyright:hover:after {
+  opacity: 1;
+  transform: translateX(0);
+}
+.copyright:hover:before {
+  left: -110px;
+}
+.copyright:hover:after {
+  right: -78px;
+}
+.heart {
+  background-color: var(--color-Rose);
+  display: inline-block;
+  height: 14px;
+  position: relative;
+  top: 0;
+  transform: rotate(-45deg);
+  width: 15px;
+  border-radius: 2px;
+}
+.heart:before,
+.heart:after {
+  content: "";
+  background-color: var(--color-Rose);
+  border-radius: 50%;
+  height: 14px;
+  position: absolute;
+  width: 14px;
+}
+.heart:before {
+  top: -6px;
+  left: 0;
+}
+.heart:after {
+  left: 6px;
+  top: 0;
+}`
+
+var loadingScriptAsset = `// Reloads the loading page as soon as the services are ready or failed, from the events stream of the middleware
+(function () {
+  var script = document.currentScript;
+  var reload = function () { window.location.reload(); };
+  var interval = Number(script.getAttribute("data-refresh-interval")) * 1000;
+  if (!window.EventSource) {
+    setTimeout(reload, interval);
+    return;
+  }
+  var source = new EventSource(script.getAttribute("data-events-url"));
+  var done = function () { source.close(); reload(); };
+  source.addEventListener("ready", done);
+  source.addEventListener("failed", done);
+  source.onerror = function () {
+    // The stream is unavailable, fallback to a periodic refresh
+    if (source.readyState === EventSource.CLOSED) {
+      setTimeout(reload, interval);
+    }
+  };
+})();`
+
+// fontAsset is the semibold Open Sans font of the pages, in base64, under the Apache License 2.0 in OPEN-SANS-LICENSE.txt
+var fontAsset = "d09GMgABAAAAAK+IABEAAAABfXwAAK8mAAEAAAAAAAAAAAAAAAAAAAAAAAAAAAAAGi4bEByBVgZgAIcICIEmCY80EQwKhPJMhKNsC44OAAE2AiQDnAoEIAWDRAe6NQyCGFviTZEA1m478p9C9Ny2ahEeI8uPCrYrD72ZCkPy259acFz3OACcbyT4////Pz2ZyGGXtC5JC9gA/G//oEmPKFEp2oiWIbpAOHoUliHKWERVpraqQa0QRjEXvN7LWre6o2brqZIZC6JwxtG89ayYRRfTVHDNKNlmZuuEpzDrXR/C+dYsaTsvWX5Ms8Bow4IQcTiGEFFkMWcVxzs8KN3jDKXHpt9wkwZqvy4O10zdctnzkHJj2idgCTPlQvn5ClN27YbJqFqF4dGYMLIZx5+kpJwkEUzcQdKE5XstcmAjO2Is1St2tNJgKb4idliMJljlIOmb7JIqFqWFZ1ob9DXtu/diXFr8Ze9yntegeIMvdy1c83/T7ga5HioZDVkGxi6DEm2MFSdeHv6ti+++JFUf9EDQTjh7SUuAPV35P1X1DgQlqoMq7Vw+eAZdSNUOAzwIfFCp7wGugCphpzQopTGlQfYy5e35SKU8eU0b9jpGY7JqAPQ2tzl/3vnDrmG2uWfmnpN/R94JSQiVV7qISqXSN1kOnGQNgQtUsJpM9fNcAQjY/8+9zdyXAlBSBt3zQ1CgtJZogcCtMcua9ToUBtAYwF/IuX9cD3oSJPxKP7k058ILaQjeNzgmdRBzJUj4pOHz6SbkgqM2/Xy6cSOd80JwLNykElz9A8fChRvHrlX//bFIQ4cIuDHGMQsdXxp7TfIgV3StMTVyzMIh2BmDbhbYokc5YWbs+u8TLy6+TbpNutYd1uEQypyQaBT3+/5Py6x+4EcEEEAAcSEicCWQyINMMsnKKmaVJKrfa+ZcZKqL1VKPN7tbT214mjasHsNTG57G8Pq4zLlqZKn2uMxdw5z8N/yZ2iad0Ak1i/q8LZX1Hfi7wuYoNd6kiUV8g98RxnA67AdScLF24D5X0rxzvQcIBUzSpk1KkBuiRsvCnt1YuE3ICcfyn53a+8PcvG87cJGUigEWiz7uDZ6b2QPoohO+HkCi9uy9MwBC4Eedn5JdlOwi45NeLv7hAuUAHb9pLazdOh/w9AFPsmT7KFxQ5uxKGJcXxl+ou9BHbl0Bl35OQ42TNlZAQNMWfE+ubUqpe3XzvQs3IrzQJ4TnMRYhEZItX7MeaACNRvvXz5AccjhO0q7nd176TpwtcW2QXBSuwuzSDS/OjgfN5/4pV618l8BmtswSYtifv2+15bEnsGsIwaol6KQvnjCoogwmil1cB03q+PQot/6r6iRAqrppMvy/aue9HUKCzBtGwJ/4kNQl4a3CzKpr5JtNvz9Hp/TA6LS665+e/v9+mm6f4I811EpBTVdOUWVPuth4nJkSroCWSPrvv6snf0m2xpCR7YAsBzSagix5Ulse0HtfPseS7cBAiFYFAFl2JxqFZMlJTCE5SOAALsvLpuvdJCXedrHbtGfP/7+qfrXvkYA/JEtz5Mn+00gT7QmxaOQJsZsqVgVw33t4AQAFAqBMgpQ/RVr6JJ1ISPZQ/Akg5Tmi7DmH0iR9b8pdqrZT+FET7Q0hlLtFM+2fptot6nqLtlk/mtpLV/N2fpGsK03pBQXAYw5b6CDmW+9E/2tnzvmlNJQC0DlITquCP4hXwAPDKkMhIIjg8DzPfrNi71/wxKFlqEktSas6bVvMO8sWOkCGJ/yN9c+9eImRmKF0B5qUjTkpxVB9rPV8YYDWayNCRerPcprbXMvlLPGrESGKaDjTX59QgXQoxkl8OnUwQhgTOrzv+f///Bvped812UtFRVVFRUVE1bQ/Pu8yprWw8/Z/N1hhB8II6iGXdXbpPv+pVvhbVBCSeTIcQ9WCLaTMOv3WmaART1kggVh3jG9DgRiqrErSv/rzQJK6RJwMfYLST2abMR/IHmlR8eCsaXUg75FFPkiO2DcsAACkIN1yaxMBcGy3bioAoaOM1P5QKbSQZaGf94hDPV0BAq3Hv+zuBxCrBBBsCxVXrXODqMrs8lZyPpTLIp1sICFHHbmoR32QT6yhJtvZpTXrxEOIRDJDxmaW1rTzUXkj0qmJZMNammVzxC7ORla+iQ0KpLlBymSfkZjkd0CIxMGehuRkq1xNRPsC0p9NArsTgIUALLvuthEATKD+Hf5/IEDam182M0FyawAo2zXhAJiJTFFVCnvAIOdzv2+9KAklCwJSgg1bThWBeAb7+msy6JpDxtSnvwj/Ljvoru+tHHmqVGvSpdsjjyWcJxqdNl2MJa8Ulp0sZDHnOK890rayWHTKl7ny2biqP9A/833l+8lvCgUCVXAFcpCHYRiDWZiHEhyC03AR7iw7lr0zhWCF2q2CmlPz6qD6ICdC2UIlQuW5/tyVXCFn55ZzG9qr3+T//vsbAGZCMtbooe2SoDLdwh4d2ZgDeU3yi5TDHNV91bM8gCZNVWCoQYuhBzsGgDy8s29LfikqdSxiyYQWeqBt6wC+ta5Ux8ZxTd/Qv/DdTE8NgTHQA7DsKMyojqluF9Uj3C1cplpXAdCfNP6/huT/V8uF6ljOANTxRS//GUubAc0wVS8A9TrfmBt9fWPzH/cf+98UuKqyWGFfP0tkkb1dLJB5tr+YuyE3EKCfc6n/ZgP0//z9/dfm8/PfIrn9N/r5sc4diAMYfQ5fcXD4d3iGv0GJt3S0VNy5ceTAnjknmE0C8YIWv/EzoPERPw4kYIUJHVQg9f/975/6xpfGVlkAyFqr8KB8vp/jxfY9xj2Pdr+d2HJ4MTwM+2nIEBWGUOkDqgyj2kfU+ITaAx+fAA7kZamWaq22aq+OZ8uztTqrq7qrp3rX9yu3PtTws+NRP56neIlSNq5Zi1xrlmGkZGFQWCHXPPqWBFaSXhp6GduoVwFAYzqQGL7c4Py/LB9mbL7cztuAHWYfCE2fNmFQizLjOLZpxZZJNaWI4V9weztbG0sLHBZjjjYzNUH1tjESAYdBIUaGBmD9LE3iKAx8z7UtbBpIUxVZEgV+v5gMB/2qLHJhWch8t0O6SH2BKQ2yVsAFD+yBIojeSqlMLLjlIwSAM+Q8t+0+Jw8I+cX5ZA++YIFKL+pIq+fpniFBFZgn87wEd2z9fXOo0TubroWevs0DPM+vhnI+wy1FVQ0S5UBpAdRtnVRi4ZeOfpqsNZrcPGyEVZI0Nj3JJnwq3uCvJPTGIk3r0g0xe6uQ3PLnweCWCA5wY+sSJaaq3nGtjK28NCYkJu13oE2sDyDU4aHJKSTF5/GTmkL2/ELzAT8I7rmYCr44PE6JOP4CswW8yUuy/M4/tVUXh1h8jAUWmF92tsTtcHj/TChKUzjE/yH1+fm/A3qAbBboGkDJRLrlqlg3s7jlx7EqgxXnVlC7qO7Nr67wOGlujm8LH5BsuEjbP+TZKUPriYeKX6Gzng26rS0HOzbvOoU0V6ASNKzl65e4Os3U7NT72hiXIFhvYp2jA1W1R/u0ppPVx6tY3XQrBMewb4k+VPBQ8ieceT7dtXumyumUfa4ufc62G6OslfPxIkT9NMDqHkLwaIV8rqiT/mc8j9uzMDPkLTkHmBLhr5HOY93izMwY5XwRxLfTeqB55ZxFRyvbDjP8qobYrQkufOg+qfRidQ9aVbQL1vDJDhf10kNJDzy3KpLhoWAx8NcwLK3qc3CIv4Kd3PAyyNcU4bY7l82/HTtNJP5+aiZHHF4xiHiHA1mApeB06hkZu0AyDuy7MTJOvNKsrm7TxVSe/2ruwRFe9dkBgn8ELlNR95AGHncPPbzpKOPmH4A53i7zIjuus82dzTvutERQULoPkjVBz0SXQTemNcxoGceqxFLegFQqRbAeytyYL6YH1ZvSamp11n9g6zRDb6aMlPHmH9c1EIel6UDOUlfp7apk2kxhokqxMxtVpnpmwOEupT4PJLCCKmPdgj0GwWSr9frSTOpS1bv194HLD7nHK1asb7gmgp2Is5lViOuZz8Z3iMxqFDpIVGcn5VatoFVI6SK/zFNsZwCVKSQhznBnO+bi8SxJUNLKSLblpj6dZacE2LPnuBVIJRNoxImu79VBZZZamZcPpBlU84vgtBK/z94R2d1OHOrsGEZ/IhE74fTLU+Ey/fJMeogzhRDuyx33W4lKpm94yTWP2USQZzkJUujMATCUW5DL1D12po/kjznZ8b6a+zVKhs5kDIImuQrHXN5yYNHkgr4Ia1omXyVYkBDbnaY3pvWM3qA3Sy0SS2if/BYPfZYETSSPmmkLraNqfqtey+qniMsm4mqke4rv2JWhvHvHHceEtIR3yRnJgaLorXDn/BILONhiEu95ldj39ro26UZJu/ZRTL5IUMqnJFSqa8ZVfmhgEzeGc0vaUs6o3sRiN31HVNE3NwrsCMffdVWuIPT/zEI595jvFW50yzBWincqy3iQYCAM/oI1EIGdma5Fva8o2PkNgD3bsPUYNsYAHjxiCGC2yqoyNDl2AKEk1UD23AesJgmZ+yWkyY3SNE4IWlo2RCVWKFKnNb5g/CjOtX35YakZVlGA9THPBiH+pohsjEAULfzRcjFfFzDs30zjRYDANirOatnAlne+EhTYeShmN7RqBxagDJUBKKu8VOvyJ4KlEyoQUbBDoIuOoy0tyxoR2MusiT8Wp9LfJThjKEOntS+IyrkaFfTQUcrBEmVrYj+SSjt/M2H8CP3wNxM97k7ZxIjwhz17jnRxtsfMVXAenQY1+4XSEj2Gg4yAv9kv1RCKJ8LUogxTMDLCPIx4rFK821+RnTQiB9HC2aUyfr6GAZtnZPSQErQcIR55QbTQ2bB11hFkI+8DmECAW947s1blojoqHDsm4sX9s8a0IqMw7AwNTeoBJy1E35peV4g1ZJjs4iLyoInzF3WZoAj0RJWoSN2VEzYku9KeCeu2oBB9A1DatlrmRrRtSQEqjx1b9A5gXjDUJBqj8ue9tWXKlwycsSDSTt5j3pLbwO2mR8dWF2cA9RQA12Wx1sKhIQBEZLxMshw4hiFueK4IZn/7b7nD5tHnUc753TbPMkiEE9/07zrXF0S0Kk7IyTRApTpvpHEZn+C6+sb0zBi8KqO3Mjb18n6sMph9lQmrNcyGRDAgY4Jz5A50Zhr6O1l5T5C4SAuQ6aoprjEGJBEopUYmpoaCirWwjtcKLkBFMxWmvRymFaM044vkNACmT7Zg2nv8ngfpzXmA8SZybLbX6+v7dTb5H4JYG+cKJJWUU0bxe+w+pf6nX32psU19rx4Z1Busj2vyQ9p7ZDCtls77fcl5CMNQite29f48PkM+Ab6LbWpUSx1jvzHoLz58c06lDM4yLgNHrpMaA7e2752M9sCn9/dxbeO4LDm9v6xV4412tbm3oUf1g+rTkc0xCZJObP32nFKzyZ1MyzQicjqkI1v8IXy09A3oPo3oPJqRfj332Yeb8OciyQwAYaWxtMqJqpzDITKUKmz5qkdFyHWl/i1zlQtD61Nkhi7CdIT9AbnHaQe9q9mGJNTzbe048nz8YI+zwjBtN3eYMJl9mD77/Iiq6Kj4Qo3k/48cP6iQC88sFFXlga0HqiuOUnNZUP+sdzwIh/1odBqfDNcajcPKgFZ0VtTo+B0jtBBbBCT6l9p6EWXolcxi59bKrEIvRfhrqZnjVwxEc+52OK+ltipeTcl8qoZgXSXL5/hiFO4KZU5/UpAvl4kYX5FIkdNG4QZrJ0eq1xeyH4YXTR7FozAfRGML7f6PXevtV6cCNqUai900EZGXHT5O2G0gbm0MYCO501ZlXcSIu7KUZlL5+k2hgHZVaJUxSdpOovgIx1UTKtanjLmkoOhOZovHcotdvhxdYe+lLt1xNDBAKQrcvCUqWXJgdOc2JrCZf6asx1NzOcjSp2IqyApaHVz5qs8kPbwcibjo2aZxIqSj+iz0aixzWKS+chL/jf+M5vphu09iktXwU/ldBfTFXA6Zb66IQ8qSqwg9beeDR7Ew2jlUVVjZ55FMzOW09tZIWvzuaztnA2grW182RLoq1i1kCubx/IaICaN7KjpayGu7JOxajwf+NVFwc2OSGdKrcER3jXPzArAx2LIQpPQowwTJsp+8vLqbNqKbpV6ZdT5W8omVI0KtU6Vfx4gMpi3KLkYyg96FnbqaEUiabz2jx7hB6N1JtvZSrwQ6KfiSVKdm8QRwzOU/y14tvsYuaGlk5qLoM75wI7OywkV4Qyknpb23dk+LTj+X3/WZ4p6NPjriJOgClH+BHd7Vq4Ver8xybRZSnr5xyUtN94Ch/BPaW1oM6MRIQmnPiEaT6HHYSOiQpIOBVPl7aI4n3oUAMA9wvTgxN3J97ziFMdKgfRFEuBk6KiRhlZlbuFaFhfkB7+8cGfTg7A2Ef5g+IhyquOLMCUVU6BAdD4Q7fQMnZBYsbdNBKPkm6zkKTJWTblCTcGjJ2XFTjED4TcA7L8WYSRQzCKaqn/3DkW/pwcgVIkSzKp1A9Xry/g0ZKilAht+9gp18HsaqHXhzG7d2ZlfI38/EZKlnoQJiMTQqLbjic49BdWZM+5oRtzYraFs0UtwWiWm6jStEr0R/p6lkT5Ef9TvSAyqzgq2X3KGYLUtTphaWZMx1J3e3L7+D9i+Gf3qmIg2oXhFOgVN3FgL7iXbsEX5iRA8nwjhmMKDdiYOkNrErxZ944pZJ+UqpuMYxb7pHVZ0egUN96Wnu7rG58ll7702nrFdmMDKLpZ5NtG/2xc+7unoErcUf8SrGQSdwH/KunecR7R3n3MmhMKwZQKk6VkGVR79PJ7kKWN9YQazuzVzj/mgOop34ZPwN1vaA6YbfAxpeq1qcaBswaLhUiEVpelUBZoAECHpiGLABYF9s4WDkOhFySbpWjlHcs56p5sOSCSoyrglPxBUCzsINa14waG5tQo1GfgSvWLhBSglQCYNJcD8ETNHUWakBRpi2ytB/gLsbm8yN4kFOscxvK6JeV557OEpzjI+Zvpy1SsyKob08rqrBCkW/uS/AWAFWWpwSCNDq6TSe9ybTcHb2NxZyEMROrMNOMhjQkBQ1TfjunvnLD7ArsZQ1XIZebJxgAOdtrHdoi7MYQtMgAEsFNHxp+/iL6N8PfBhh7d438J/SPAtal4BsKvejsSUSiShs/oVC/XInT+PpBT9m5Rz9Uayfr03HHJb2AHlx/JUjYXTG4TTW9vvmSTSfxdPjv+p80l7hmFnskQK+h0YBeWfH0X2Sbl1spsajutnxCNmrnc0HpRDjbOJ3/z6Lp84IJtHVNPgg79KgbNRyvWm4bmaZkqqkIfU8Bt6ChGjjyZNf9kqDOv8cWGbgbUaHPeTnnlUJSM5iDuHl1jnbeZRGkFgj/6xgJkuGIhRHOSCcmG14Hr64mra5OOWHKCiVer+9hQHc9MTOS0+BKK6xJttpptb/+mSgn+vFP2XR5OwCFfd6anslfMlJzhQhpiqR+BCRpLyNgYFaJUnn4egstB0/nU/IyGCRD7Gdvb1ewVAiXTxK63VZsKyHVZnkMIo9duPesbIgcS90yavFTU7gC+C4ktJriUlchUxr4Br01335Uk3Klt1zgjo0aSkIHEa+noJ+NbjQRVSHAV12rctqBrLUS/HV4qUeoSIQarQGAEQV6coLAdXZHIawYNlF/SgxiOUlumRfHE5goMaWMkiolWQB/wjpsNKfMDXBr7vnDXzDwCDCWxvMTZl3nCEhn+wYviTiATjcdTVOnZuFgxxbVOkC6OpWqUAg6j+hGyEyYYJWU+zMmD950h7+/dyVyUiZ5PZAQoului1/DnkSHjUNQO0QaJJgZHJRYtft8B0DjL8/EUjNUCEJnPvf1+k0P5PB0Rb/31hLnja0WyTq28KEpjY+YUwaPDGllXLHpQF0EuigkM/jx3UZ1zMMQEfTxZmBfB1sEJm3crixVGTY8nZFIE1GQoyhr4Jgi5nVubjJDU4hc5FJFAraaOgKTE4E7xTBVKK6akkQUuqXfg3q97vLcrOT5srD8IHOJKB9s1yFZwM26JLkY3URzA0wmenRqXd2UV4hqejYe/nTrm14fuGpchbM4E76tGBXjkcOew0JbiN5innRRS686vryxooynnsM/160qvAHwjCAYuKJuhjgKMrm+miT8frj91XSfrSgnQdbldTIZCI1FpJoxPADDJhAJfvqy/AFW9Qfy++iX3QolCmuQp13F0ay3omFELTgHvYfojKT3MQNz9H+c/gCXWM0udwF+kH3zcij4zeVMAPVu9vP3EB73nW5pnN81ix8XX0CXVJnpZnj6kdY96QxDuCa4YWEkHB8BS4t22SX89ws9KSHVbZOJicNQQxKjNlh1I4s0hmseTGwSvgxsM5wQTI0GXqhRknN2X80wLitaEtGDJRkcg+LFdYrAFS1nQZdq3CZAdwCZRmyCwPPR7lre8uS5T/X5sbh3aqRXXyGr8NHvDHi/VdeSAK3gsKtqZ40e8v+XWfkJKIVObo7Ff5c3V5lPe8VfvfeR/+Hxvz4hcS22CWno0iKs2D98/vmnsfuubl7T993yburV0Ty/V7F6kNi1+/dMfMViIEWPo4IfJaQr5/wRqvXbgWyKf69vCANmmV5J3/8eZEpOQcThznNkNYfoZR2lKS3wSmiAjvNDPkt0JpuFzGZSJo7eXsmdYTu/bKaBo9VnE3YRlvlc/awrhXb1zyOjYMHFnr9XIhxbISClz4njB9UvbrXewaKag2gJKSk0b8MfcEHPRNRZuFeIw7fXi4U8KDT/JegyT+ObpCfkNNW5HFdJbbB2qPCw7nH7poXS71yyZuHB7xWJkVYmedygi9h+eK2X1hMi7zc3b3RvhhOh/jsdqmpH/EG5z7DH26pEiuvrnE8clzzzQUc0lGlG9RBbSBlHW5NrOiLC+QEaS2jhpIlDrRdr9RzWD07TTOrvMIM8ncgCgncBn1u2CaLyoxMRFJvV1jSHmTgN7knczpSplDdsKbGxFyl3/lS7PNSTbCc6tOhirkM6vhgD4SuAzhVC6Ju1Wswf1OaGGqiTr8712OZ0510pRbbvFdN7C1yaOjdg47etUvbJGZiNsb6i0pm2+pjCFC9tklG11/zlEaF865AKoaOlvCycVS9m4bQVTurVgboMyTWYdX2Ijrnt/QIA5B5ARJGIsTystq0RSAsIONVf6CU26gq9culTvgRoFPVsyQRn0TjZ4llOU18pxzjyZD7Z+dncDqgUeDGlywe69kbGS7yfnYkuTQgw7hmvOPeicK906sMRLrn8IgQxAWnI6X3f6uFVdY5+LvhELJv4WawOPz1si+JCD8IXTLlUcju6p0MpDKbsXgpY+uKDlReAa9UFLkQ3WsgvwqLDev5RqDoBrMYD5CRdezdvWNLiCaZAE4htZmBlojQ6cDra6uMtPPrane9TsnSn+bTaAS5cQEtrMnb+hv3jqjXQcGV8VSsx3R4LQjOG3zdKNir/osrG6bl/G9GNZS8OI+7CITC/yPuOY5zGw4kwT9GhWISOJACDu3R9/KBtBOSbjJASbELnXubAFDY06/RitBcEaaKUkwCseKv7B06XmKPKgC92aT9p3B5xw/LRQvf+2cuZiKvP2ej4/f0o5jQxBrtqDdklmQceJr/lvB0wnjNywWtZ5OpTT9dWoxZss4jW7xG0+9H6B04D+TAJMsArHS2kbaGB4ireck1u6wxx+KdUKZD/Br+tQZKkajRiIrDP5OdG38If1teJvjXU8cWEyB839Zao4UFApqSB48tVP9iEiRzVv29knmx4b8eQPqF3mTeTyHHAIGgUYQbZwYoWZB6VBsNHEicLiTksKc2yZbGIjnJjSURVKIySuvd3j1VX4A/ttyX0fyU44hDJAAJDXHdPWQ8BX8Hqd9JySZNZAncQ5sszEDP4JNsNe8Kga/Pv2YXXtkuye1tqEvpVdWoSTerwXCqczE97mZA+VP4ke1vmIsDLLe61vgkaowFqLXEAnSzfloPicGG3fUWtmOAkegJgWUEIG9hHvzhT8GVSFoa6FJ/d1xf6gqgdDjqdekrYk7XUqDNU2Zu14XL0GaVFQrcfnEb40gqNZUp2UgzCCnqTxs7ZHgRMGWE4iJWEqVdGHce2i+Bjee9JiErn1sJJUt769MuGRq4gc4mhmFfFHt1qNvmmLUsZY25ah1ftuYm51zZACfN3opWLspYZqpnJA8OdqMNfcT6O3D9Ebnk0eHC+NTURNV9HymuHQJPIjjddt88pgtK662rUGO8WzeP5XdFrlBCL95ofa6PBlz1tEKuk/qSwBl2YQI0FMEKUqDaCVjleZt9POTBGxQ5b12PtHW/r9izyiNLE3K6/Qbcly1TUHE63g9Ry/hL+JrpsKf/LrWMaWEJv0VSgK7PvQhfYgwnsyJvtAtz5GyK3DssxOCgtmq8fzIk4Yr3nj9RmxQCHmZELzDqojPDY31+UOUShNzbg9q4hSwo1jY69Tk54+Y2FfztSv0Sn2/kR81X4UdCfXueNToOx5H434bwsa0sqj3X1XN1BL3PTw51r4sbF+rbDaP+arJ1dTxlHhIowU+zdJdFulWfXoe+1Sfr0PUnVihYuwsRfqtXonkFP/xzxWEZ1ppABND1E/cQ5pG6eCRg8RaHLuQakcC8qiQaeP+0jVitjKrcW1Cxu7oI/wguktk/OrwPFd7WhJAi+ltAmcYkOXxw7jQElcx7khFls+xMIHFBMIu/zKVrlSPNRYarLjl39gSQvwu9/kl46kuRLuA4LffaDJRPiEaUyAtD9pQJgITg/o5bZX7yhX/BzU8Bf2d6U63f/cZPrYlYuYMchsLmLInyc/2eSw/uV1TGkqJ3Sd+BqqvXyOlCXO3gVulzWtW4qW7AJvb1ii+il9eDcOmK3/hbm0b5uN5Liiwu3w3f9L0Kfc/V+PFOyxzqEcCzLsE/NTCc0uMuL5tC+v0/Q3y8wYck0Lfs3zhRfhHcXW6cyRK81PpmaIODscCnEAv0IpGhUsK1+likIvmXrpHith8R8iz/JfPbeg9/QhtguWshewHgZa2c8k2OPd+B66v3ag8P3B4Ht8u7dueA8tqsyLegFcbb4RkNtXRpPWI1CIB2plc4iI6W8pnx6k+IUe722RU9U4We6rHDAMW767uKsfSNIZATKUNZpB8pMqBl8p6cCNl7K/ysbCvdJG9JC65XTS6DNizF+RAArhoXGQif0C2lnF2zoBun0V2mRI9SqpZSbmmF6AEF6eNA/dndh+VM/ZWAdv/ikDsj5tU81KvedXXJHFUPGzrq3tiEKHh5Q/XvDUD9mpsZ2cwc3nnzXiD1Qx4u/MuOO2OHEULtJqLmbwuuKXJEXjhe4MLoALfhidTYs+nUpJDUroTanYn2/7LhpBwTrZLwRle1a5pdqzpVE7WR3BOjLGgWTlss3VGOmdWLTL7ogKI7b0gHTipJwaiOAKAdQwT5i8ClwMmpcENUzNTZjxEK0umECw7k7zClvq0Ew8Haab6ifi/QncpgrovKM/1c7Nzz/A0ASsJ2/4EG1ay+ywtTsiqonJV6oF+VhYxrFTw9sjAjySSwykg946OLHX2id5eYm0k5pUVqBBdIFiXGP+mv0e2ZlZaaBuJWK/+Zg+8O01FR5isYOlvgnQZzXVLfJjuPdqyV0uEDPPqlhhU1a0gbVoxWMIQZE9+lK7s20kobo/+l1K1ySwdlcI3IkCFZ+gmhGjxbPWp+6ODg5Nkhu3o9RCSj67jcaXby/OJ7JJYULKBuaxZctaDdBNfMk6YBvTzC09Bjns2XzoSBpgcl1NxP/Rx9MeIYx+oPAPQDF9Y8bpU4jHpg0aVnDRuy38Zp9Xo6Q+rojQjQ+d1dzDDJzALdR5XZo5wSw20E2rNuJl1UaG6brnZytxlBx85yryFXms22mzR9X77w8gzFs2btw6dR/GRyUXd0MlOmIiZv3C2jolHDDbHwlqtcoMcK0jdJn1vkyh2hVPRgbncaDMubkzQNvHCkTtYGSuLwguyhDeNacMjxQoH06yGgC9mKheLe/ZznkL9rFeiE5ZSET7aJ2BHh29q0dk/vdlCpiAkMsFnsI9SpXte/gF+2Z24pOmKrQ9+izOOvJ1iY3I60ouiBDePIArl/VUQp/OgMXkHRn5fribJKEVUaXSu/TOWJ9KBCsT/wrnTbbEcEdDB7ltnhpOufpFHxJFkfkmozpgfHJtPMNy8rEQmgkHuRp2f0u2i+kKl+MqNfrMysmsamJgg4Cg2Hm2BhcDO0JNs+UYKhAm2hb3H5dXcJatz0nfLopmFAt0n6UU2/Ork1uIGdoui20Qn9Qf0mfzr/s18aS5wcw+CV4t2pVdaD9N0fZA3wXUvJtxp2gAgcX4teRX9yzpOhcebO9LYHlGPGCGKdbg2NH4pnBlHcdJmqZW7sJKQ/wPXslSYM5SCijqbBIT2ZwgDlmsWGaeLiaYaaxQsl71rfNVW1f3y+rl/fecXvLlauVWqYKimaaqhVuFD2ru3t3aqOqxfr4JruS+ZSQaUx4uJ8HLXmUYnVNwycDdK3C4AaWJki0A7mVBZU48k+CVHeRugIE7ecmJSHqYmL7c5OAZAqbNWzgfGh9e7ikIx79Q62puY+qo++QLmrh2WE5MuKPDDvsHnvKA4seuv/+4MwClQ1m8V9mzlTpY9vbkrXsyY2SrqqV/jVFO7UrBR3bGRPlqzefCvdzpneLOmvfsQvRQmSiE/y5X7myXOpdt+nDVOGeejzTs2DR+hZpK9EPPeHqILhgqrRqsLhwopRmdon8vcSRfogdIJnZ3zw+TXSNda0OCTKFIFEmcPgJlgEAoXhUHhLFKIgPhdiJfIYlRzF3wT/ufkdH5x6E/D72+/EL8HSMf8zM+PIxMXiKDMzY0gkaC8Hu+64eRpqztaEl/VhlgU1XwxHxbR3+xUoxiPcI4K9Lep0CeYJNdGEt5YpD7/tjbrDhkqmuxv/fqMmKoJFCLlNEyX8wAHWgf7iXck3LF/bzXYcZLcUXbo74tPC7/mvJs4lO31xivaIxFXLeRmFtKyVUp/8mDGC7eiv6L/VrxuTXLr/dmKlO3CZKg7IbaDt6MOAEGkpPiG5ePBehJL+W67ZvrnsjqLd3bb8bEoz+WxmbS9d9fXbHUkBb1E8g5ZjgN1UAIpxGcWKv7z8TcVKK2feZRO6evJ15x1g9Xn9WUWcwN9v0Sm1xQkxhbEmiWBkkHZ+1TwEICLy9nbra6vwN0ZRkfT17c+zjS/p0nosi2bCx6gOXn+k2JiKyVqsRaWjg4cC7/6cJsebeRWHxCcXRBAwqenpGg9TNUNzGHf18dFulirSGJyhu1ykiluYmWWMm7pRKGzQvssp/e6KDYfdx4v/golWy7YdHbR48nn3LWDzed1ZZbzA328xyQ1F8VEFsZFN+c62kJpYW8iQztxQWYZCouGJa0vkfXg3DjYo9EZl71EyYjzRZeqP1mU6Xzq2PpYTo3TXH1ka0VEsHF4alnVuOpY7tl4isTOdfjGuKXAAutjdNMIR4omwCLT1xiWSqokobuBB0Pny27kulHSYjbefhQNCzxkje6VXWYpAmSHgJlg4HGVu4PvqdaHtVXOqLzoUxwKnVTAtNLVrmdwZefQDsGMywQVnZ93maPRCO2w09OSSkuR27GsNtMGeCamqC518sm3dsfwRUl/gQfGiIu/p7wPsyKQJ2MD2YnyBSYTm4ykiqaDLxKS4XL6g/I+Q6jS5XAI8cs/KP1PpqeSKUS5OjVOnZSUOy9EOzCaldsWyUlfzFHWXrDA//8ZI/9XuXUW2U4hnmX3zltXWv4qqkLiQWv+7SKWbf48uhUnoXrE8dewTceTZ7AEfGlin+bxpt9Qe2z+dcbS8jxRqVRGzcrvwKU6IqQZQEJcdUyN/O9Bdbol2M0r80W50qv3ApEB8TXzDRLf/w2zVPKzrfDQW11AM5eR6nir1RTjfxDOL4FpegH/70Bp3fwgz/J5B9D/tDdu7sFSeaHktCwHAdWlz6SeAiJ+smq2ule9UuhlwrONAesu3AIrsawBF8uneFT9mG7D1oDwvRDhDiknXSJeAnMeMCQtts6oLVxZz4bvJr8mVtgJkZPi4ejA1xXDicym5RHKxVubvdv2pHVWA3GFLejYKAFGZm3wgOZjuXs3t08EnJLOXSclGI4D5d6TkqHTigc+Fo9yyfHzwqSiYezZ0gUB/wPZk/xkLE90T1v2nh6wSiNI/b6W/slY1KWUbM+OLZxeJEz2JWQnVsS3tRZ3TqR/4LiAdowxZ4OIlptTmJqNv7yiPjYlr8m1paEWU+3tgPTfD7hMm7tC6DEZHOAS8kL/EeXJ7W2/yyl3uVNW1IMPXHhsbvGC6at6yVTJOtnP0eMsetbiIqQ6LxOM05c394QVajYblodBilclPF/2bqM1+UdwYcbg55wtlyjijLP+f/uv6sY6efqx7rShYlHgrQEH8w/+W+J2FJZ8drPowmBalusog5eICIGdI9bqVf4K8K7PxkkEYTz59NHdwQ8diofAQVEdtIb3DBKZ/bfWBwchOKELsir5A45iODPsF/J3F3zLIEvDm28ocNUYCI0G6tqQq+prbtoacLzRR/VqeD5dJLmPD1i4HMtrc/NgpBzG41Qgyegrwr6eDAIBUA/jpl2n1SblSyaWpg/t9/9dsn5+HjduoI5oP17HoMKY0Jq6uqB+ZGckkLXIAUX68/JOVSRSaMjbMyeIgVw1E45sQiS3Egaaev31kd2YptH3cvLwsaxXhgqgOW7l9BefdJ+MrFxR3k2oKkl3yKdooKtwIxtbmSNk9wtY7aQbGC7ntx6EVjFIpgwBK0GWI5J0mVurA1rq5grh2kiXGB4mjxfFJK1PRYA+W3+QizOyM0qQDuWDYdJmmK3/8ZMFkTn2u6eabAn4xHpatM2kGxvdy24fBjbSMEdDSK4BBExJFNEGtNTPFqc0kRMGdzIHiuPS1yYnkeXAOC6mQDJCBTvXq6C/VReJL8saW3nK3ThEXqTlLiQFBqqW1oIx82szvJFmJeXBZ5Ub6xqtGYsAYHLE8Pj0TFymhO2TFjTZHuLkj6LUhb7DJ00Ilgh6aPZdLW6odKDl9MZio88L4TG3ptOqG6KsPd8sISTmhXK91bBT1oJYQSUsRn/ag0Vcrj+UJtBV8+H8mWZi4+Sl/ewRBJ2/YI8KJEgf38gnEPzz0w4d5ErxCQ8KvOpMI8UFlOYPbopcAgy5znWl8GeTR3dYhJeXKpZfYy1sRfYgRWA9ipK8DgeiBIRA1tUsfKMekERO8onv38nXP8AC5p7vqoIxy4Gltpu4M04fPIkBub5K8Yy8ABl38oNnTKq0uPpvJVXeicxwhK2HwkTimPSFQRCtr1m/p/R/bx6iAGbIPvT+6urlzmZDLh7ITuodgDV6zPHtgK99BrWg2bhlUUK4IfyzNwCp/W/shN5Gij45F9vc1u4oShjKRmyMxlqssbUniGcvj0Ap6uly+lUuA9WYTOMJo/TuOtSURw3t5xtTJvKSWUWHNeLiOSV29N5kyq+/YUZbU3Se6mTZSlpC6OhW7OLV5JkNPf6mw5dVqArYGchYtAax3tS9zx1WSzrMVLFtGgB+wHXKr5fePlxpeYif4FmnV3AZDhTU+azdf9h6fpBtuLNp2HqRK/fDXdhWdRWaURhS8qVtZ6jNDDQ4jYyZ9VFzgzXpZKrHW2iEqRl1WllxDLjFshmYuFpVeTZboi+Lv2sPa5pYW5rqjAkW0Ciaj1mHrK6sbux+6Ez0TAgfxb6PiV1iFVi4I9vY0RbOJxh0IhOvMnKb84sJjJZQLyDgxRlJUmnSCQSUk5Cx47HWegPw6SyElFUJi+mMHlLQZeiqzrg6kj1E7LhC4YknD6tWrhPDQ/k75m0IdypOhQs1Yz1BPrYwFnIYopTOGAzPk697W/OMte5tkI2uGZJxEQbohyxKeyrnwYqGvdHSjrK1CP5U11PgmqecyU+wc1euelRwQg8kJWKzk6hsx2jfC69JYD1wIyfg7kgdVPVDeCsZRwJ3Po6BHLHX3iw9Jxp0A3sYOlNHeqTEg2jdiq5JYO6w/OfHFYlU/Pz4BFz8Xm2ThAUWOKMVoP5xz8600DOe0FklRs1WJ062e9QooNojjwwklqIHn0/rJlG9ZmVk1jI1NjREoMyQUhUMgTMwldAc/RmjlkC/WrT3iW2X/9W+Nd5O/u6G5kjxFBzpVrOVVkjuzsFg4lIPK4LUSMZYWXV8cxaDGmmAxm0c3+b35RHuDRLTMzRb9kiuvY2rQxHTvNGD61a7lvcf++p5paVERKUnueqGhruC0lKiwlDQ999BIPdekpIiItCQ3nZAEG52UhIiIlCR9t45Qt8w0J9fMNDfXzExXx6xMLZFUXnDVTcGP15WcNZzVnDcvb7iruWu4K0+zf3y377CTKdfo1CjPqAiQRdzcJAUhVYytSj4svmffJugmadQUqhjJqbvor+ZvlqnUb1Re5z+UY5ODLiy4bN3eS4eNmIjPKWEuU6XL3VVdRPPUkh10wsZdZOWhfka56n5gT1QsoUHFGWJnymwZqWeopqD6QV31v4qCoWaW1vl6f9XVDPSUaYnGp75HHRjZWFDqWx+rHs+71xCiFLXy7Pr5k6PvyzmZUHCBt5p6k/zaqqPj+oM1x9V1xwcrC7aOW9tbjo8WbWNWujuFhDs6u4W72wW6OjtFRDo6OgXaeoRhQsr0PC5PwlXhwUFePl4dAR0UPmQICBxqF6muGlIIzc/JjoufI9GGkfjpK9q42NtjsNKaGAX3gQ5HTkdO+w4X9/4FTjwbuBy57mXF90saMQGGo/mHd4TllkihxiioAQKhgTaqiuup0r3K252ztbObyaTsb8o0ZNJu9MHkCCaAaFtpmSj3kjNnFnNzZuZycuems2yqrKwrq2xgHp7Gxh4EuImnBwLh6cn/obJguKBytBLqnBzzSYZ3htXbkKGZb0/DAsHe2irflB19rR2jciK6sypSQ3D2FVkJOUn54hIWr1HmTmZijiAnf9PEe9l9ZdG69UVm8SOZ8auh6d4TySoQdQ1NF/E0XPBmsZZbRl2MJlvrAacGNKpHholV59E8TIWlpO3PYUm2mchgXLCETh5bgPPKM7Mll6YcEgEvi51casODybMBlIlz8iLLVt6xePbg52/yg7/nENm0x1b7EYO9i+65omFAtiNU7VTWcpgD11YlYY0G2TrJsiAnAaQEcjuSy3Ab60CO7Q4hmRt8a/9MfcXAbEvX4L3qqoHpT22Bpv42NlAbGGzqp3vkJ2rIzE7o73Dhsudy7HAkDCxyepgGTnvOByWPAVGqO1Q5afo+mHIPdKyrrivKzNfR165DDSOKGT7yf7h1NtuFlAy29Ay2dUTpuqBlP+rP9qkI4GWA1FT+CgDpitup8u9SHkT5B9oFoDXQhrZJcXVDcqxHysFbvrrwKk6KKQ4sWStJnLVPjfptT34LvFkZV15Tbm9khgOLa7z2GAqNS4BDEco/BoGEwGPiDKtRrozjsTsT7peaO2blOboWTi1FW425W63B2Cbl27vlLt7/G/gSnSnSgnXRzt1/xqL6kunMNEVkBGuvlfv0kNWski6ASBbga+GvrJ2KDvLR/esKLcOL0/fpUz3xeYm1Mb2tMPjeW2OTNAMlW4gtD99iupO+va6Vk3QgvG2/5+GsH3i1rEnBaL5SF2IdhYxAeMZa6Bt5wVSM1fQtlBHp5oGJ6b7e+ZfFC4Dfn16SdRjc11GW2lan90vv7Z0pCpPF9fw2DLLNWm5bwf2zW01kzatHX2nfJK5RoYUnetBzlhLdggF5z/wM/R3j5h2L2yZHvtbUtscVFiW6ZFDUUBS6aBkyBaXkAHMOUsJKQ0P895gAf6zOzqz8+5KX4e0ZBe3xE6uAUN3p1tqf5dZpGxGWWuZSuOJcvXDzd9nWSVNBlbkNsZ/YEivKUyvTWxqqS5uyy9LHzAfgquYcNbwz7EkG1oOBdfXh+vpGSE0dQ9ifZIeKNZCwyFsD5ZdhiwnLr6GyCYajKFgP4p5pb+qbUamEAWSK4DLkiNZlaUtKxGgBTIqEuRUHuuW541Re0LFsNvN5dCZLx3gut3kUUEZ/B05hv34JGIDLfspsKQ6ugqSWYVF74ev8S56NmTSgBv56cORgefRGjJz1mPvweXATrSRdtoxjYkti/1V/XUvd0oJUEbVva+FMQUpDn+DDpJaq+JjBRZ8g4L9gC5GD+e55eUyVpfxC98LJp5Pmk+tPllvNWzbhV5GJhASLrU83l0t1LXX9l/3xLfG+vGTmdxwYVK36zX2Kyr2BCbtuRw02ILBA5rEITc/nFWdm9HYOwecL9otgkjoQeGfhxkbJtmbY59Mnl8MU+3q1caLvphEXWbKHkWP1UkLvFae9pm31WJjYlW7LdrHu5yovKMcGEmTlE+hN7qIEygWa0s23XYCvG4iNki6m/8MRQIPrB3s6ykxXbgYck8X6qd2qMV6RAS7bDtErmA1bhmwsr2+4dbUN6WjiT/HgQYCKB4s/2C7Lx8s6XT/UkU3rAbiAe417AwwWUd3eZ6Wj22XfTsYJAdAiVranIM6chdQF2it2VSpGJqlv0RuuBs4pC7cn/8V54ivqlrKuGkKXiLPUHE68XwhVoj+TslCSnzI7PZ86W1yYNGNQ9DAgI4c653NGfGFg1wdPforlXObsRltXeDf7Mrs+tMvUgWvIPI7NoKd5aHQ9syXzlSvW8JVgi+BSv3UZxdQXKU7OP1wIfXOpm2+8bJ8dnVxM9ZbSGHLgFXS9tIaDZnf0zKG2nvVLwYuQwLm2kVmzYN4GzQErXkMzsJWr/9/R38P+EE3Vdh9jXQHIvz95fnpZfn8EIH9QmgGEpBhfz8wowNJOmLL5CpfPGBd6/OMd8kSW48CcGyb6d7NFhpIarMpEOXmispKEErVsVhiIMjW0GreKaLz3mC7AAKCgt4e3B7xW0lJyYmf5+/Je+3uzs2MTa/vSQFnv6WJmbULGpsnoaofykKUd+JvGx+qVEukrk0V54XMjvPyzIgkmqhBVNdSrWqjJJXf+/ZgK/iAG4eVDIESbNHDOruh5kev5z89zSuhZCXLHpxBi+8jBXtNL3A3ZtlYOLu4pWAlm1czE/kQm0TcH9aMNdwqz0RPm7DhvvEFAHk3eXJMIVVsknjiysSiPTRNSEvz8EuMIHglx/l4ZsWkeShNy8hNKPr5yRKn757Y20vNFEBZYcGiQiwEq1hFWDjFA+gkppB1ImQuZSiGFhDDcITE0OSnFBGlMBSGGjOYKiadJT65QogUdcHoWYhpuWOcXVky1f3B2Bo3ygb++HmDcfGXfuTCKe0Dj9xzsMI99Vds8Dn5oOhtCbjToJWjaq00X7UdNpfdEUXlKU/unvOLBpVZ4oNwPXU4tp7SufidtG2VsikSi0EiEKZdVbcp+51cgraElcTP8wtyfRdwQd5N18LrOdiBvMTgYCYHuiP3Z00NmZoZDlv2DYzYt8ZvdXMEMPm+WXdjvAgiI6sEy72aCFyRoQ50+rX2iEBYofVCqvSy48XZDC2sOuL5Ir+tIgxc1WTjUevR79unm7RPKtc/PNTQozjaMdJhyQaMP5nsbp6faFOlFvF4KjtCnVFM01ubeUuQNao++GtUdIs/N+t7QUk1Gmzwm8NJ79FZsn5q+272+OM7sijfavyPDyq84cTqhyC3LSP367MUgsWOeR/1UnY842931+j1YSRWwd/Tx1U6JWJP3Jn4zoNKnEb0m4mujp+drKb7RYlrpHbxJ2Kx3r5LYf338QX8q/V8CWseukoROklXaR+mDheSpM/FTZjOPr6TpWG+kj6PqLFSwtFwuKbNcGEGAR6TmlAT/wfBPMwY+Z09TThvGkHZjKRj/K/Kte6JyypQR0h57d96eP0wFc14CrPKS+tvVNJ2sbrZprTR9WmNBlcMsIikzKdI/I9QUffnHSp1XNpUbArt5wMWNNDqtosQIZUukbZvecLZqS/qpi6gJCDd8K1zOPqif9l6QyTNw7rOQ6tJyRVsZamiF+t7F3vftuW76wUz7+4rr++7w5q1FbEhgW4Rus2TramF0SVlSdkmjoUJK1VBDXREWW8e5MGW5Umn87cv/BzXeMSlp/DTbqmtZX6q3j4fNTIcD5LO44VfpJkWwKlOdIt3iNOKVlgmqWlEwzFnAnG5P9MGLhepQlmP6f8+nnlMThWt3h5jU0jhr7dT8wsDFPPX8JaFgOObqqizNNzq4NH30AWb++w4l2Ffa8v/xnT8U5TkVgCyS/1wuEPqtIIWGgkGSjC2NHDj6NCeBFPhPFPgviVSf4wZL2l5ia8c1BI5mM+xrHhgm5yfnLZOWSRBxczDDaKDmjRixnnlgfLZqCUg4nsdUGynFfDFci6ETtvO3EvEqyDdYzaEUytApeMemBxDB3jyz58ca7APL2hObdC4DNn69PT+T8OhJL1/NkKc+1N4Oyi+skZqjMZQlfurVX/lbn+RV7tTY2d3bM1WSZabq5KPZKNAolBtuV5g0ca5palytLOOpFMLcAnczKepU0xoqcGDToBTKqaq6rHpNaMCVTJ7Gh6rDhyWZfkq/7Ii1pKWJrOT/yEsPFPv6hkblW1hG8siUrKdjQ27MvaXl6Ald6/941bB4WCFPk0hOhFNx0uy5ni20Wl7WWSmGfV9y88u6CrM67VCJIXyyWDOkNntPFaF4VZV48SG++TubEIWeBweReYioVLTTX3IvJ7Okt+1rRbfmYus9daGRGkh/p60D1xBeImGyf2jITADfiKOZkEli+fr1L63DpUNLfGdOWdbea3/uQ/GOj2KVXO+iNSlVpNMuj2lBTCIkskdUA6H20ainkRgtac3J+bX21Y9Cv/vzyMgqe2mOWfEK3X4Lzjmb2vX044cwOpAD3EkW6iIdEyAmIXYz8Xf+uZC1CRQsw0kEs9nFlMWrNSTgMcl/+Iwcq/N40HCUsmz/FIoVoQUDASh8Ruj7lheCea8lB19BGABMQkq8DIcvDpmB7Hwskq/lJ+vGuqkBFH5GDCzLx5Jk9erUukXnBkVaVDVD/kseo6TyJ/KkwwS/uZE6HXr93Pd6xaoMlaTHUivvEEs0iCXUqvnLiNpgG+SJ4N3A8aGszVxdk/08HSIJS9cXLpvAzQm7kMPz1bHs+6tPIEAV/L+16xOWjfaNTqrnZ59Pml4bbI6A35l7Jga+6bWAEV+ePXS0XYtTKxfSihCcOOB5zhxwgeB5rhgJSbG9X+DkjzTgWM1SQ2CHPSK0jwCnI0FCh31zwS2tQQlRTb4LViaROxMHXM+Zg0vl+ev48lZfsOFsAsc9M8FNbW59RKN/M7PhP15OTkERDc1OcvPALnPr+X0iszYQiDUHzJV+KJ146iT94loB+XJiQqWrPGLGbean94M/1PT23g1KiG70WSvUpXkYXARcKuVGfb2YcXlXer/0nVR7DOurEauQF9FcULmvrAuAXlp+vXxnCbC1IPMVyPbm7eu3bG+oTWDkLoXTY+0clHNsa6MlD/Xecor0m+24yF4r4pP/hL2Lfj043zZ24Vjmm+Ezp04w9Gs6rVU8p1sxomUlHt1G0nyRopIbzWcHUBCPv/QxfwfKvangUAJiFQCctm/e3n0ay+glStMFNfNGwKeLRrNEp82emIO0Cw87814XtCHQSh5TTuTZY7SYze+eRKeHufE+dRXztY+xUQwVHJUnxR/LezZT20aBSlkVseari3Znpfp9szcoXV8jMuQfS/punS6OYK+5QoICk51HhBKl+q81UIBN+osvZ03ANeBZNL2KxkmbwHH5U2EVvo2XEWWNtoXrkG3f0IXLpjmWCBI+/DA33NQ6VN65Kk3EkMvD8ZyxKVvPsN3MfEeH862NLSEJkXU+04XIlJrr1MW1Lmph4qnAKfOacpwtNrFjhYXZ9abe9VCNaPNf6lfif/Ovfc7ScnnN8QfMM8s+yC/H+s0IeNOd556I+7uPV3fetm40062evdBKIj0hYHPUJM3cGI30NCgtQ24ZlD6vKi7XYAjPsM56f32VnU5nxrK0tsEKL0B6I5lqk9hd1NdZd+nH2/RXSuPW2cpGn+iI2tRWIsSzMuRXPpPGI0k6cYFHcMVK7jqenFXqNQYN7Lid98PXJBc0IOFvX1k82ToobUw+GESrE+wHr8q/6MJS1ZffSAAT6TNTves/dr5hxNIPb/41jzWMY9Bk/cITZkjQIvBS/4qI1rFSV8FOnNZcYvlNdjKPMr9nHujahXfmPKdlaaxrzj7/yLwZVWiVDV3T4Dzi7OK4HWY70CJbUVA8UlxWIH2mNcR2a1Jb2VQJM868bmyHo/rnzS4eva7In9zTBKYJh6S7V1GPfqLIhmwQLZfzq8wwuGrTpswhU44yrLo7w5zQiB0/9pWsHPWmnlCpDT9WCaiKx5KugT7p0J8oKF5iLznVVD/UKHK7OxVWjceYqk6YihLCHgg8O5NXUni5JVHGi/5hr4k9iOeWaeRDfcYNf7ZWTJZsjn+KVf+E5DfX4lTBTlNNX2IvT6hOLrHKsBss9qrquuoSK6oXhp3wqrmq8ZoQtXeT/CkjTsVy+uKUkkVJ5uavpAgTM/GESMUuIwmd/9rastrC+KuVuXlFrai1uXmpkfl7M3PjfRSCkdiofZJeh72sIqu6VGIPw5Z1D1o2yTkoH11iL/ddtEc1fPsWhpsaNPyCAK7XAD8a8Fb1V10pVqYS776xfifGi0cGbTFy7CTw8/ndxq7L/ovbGtcJiDAAF2UQS6oJ/KhbUnl6fnK3sedd74sN7OX0/+0Yg9AtzaGCeHTK3yvslXpJJesWeHO+BrjQDxT/a+WOJk1YFE4phuVeot5CA7SCj1njrPeMpiw7N9Xtrga4z5CvX9SSgoyfnZ1NGkJ4LM0IlPtwCIjl083JJfZy+jvHEenXJ+9oVL5RzEQtZGdFL8xMRc9lZUfNYTjvKC8H8ie2xtS/GFy8xV51v7iwtkyy8D9CAFy8MBBqZcyVvQHHdLFWfDfg5mlZzsgmLn05ni2eh518Z+toJDCFBQgSZOWlF2UcLwJDh6o1AjLy8xlLznvPqRtzK7OqmqFzqWAINX3ND24vx5yqsWiL1yOi0cTkwruju409kZ68eoHBRRmEj2pOhEbgTAy/VK2ed6TuftP3euSk6+Qj9uN07/SHpv+qnxGi5uR7M9xs7eREVRRVl9j49KN0hcGJ7d+l+OxfiXxpLYhc8+TyuzjSQ9T1MPdnHWaZ1MbC5wEv9VVebmFzsXnyxlYgxS2MsWA9OFWDu/X/i9yws0GJb1W2Y+i6IQJ8u+zHG25oxrdEwbcQ+l6VQDwzq/0+O3fqU5UHcSzdEHG+hxd9rKU96UKIXbWx+myANcnVC6F/J+WZUMYTtf0oYK8BmfDn8wG2ashTUknN1CVLXjLllx/JcC/BvI+uXpXGj0qNtXh6OCpLvk1LHqWoDxAXw7OJ4ZO5I/MDw/tw0itFf9Bb8j40b1dl75TLnNTCmOi0ouzM9NKoqPQiFvl+oWTgQTJ5JhFAuX4xAm5UVCGeizB6tlfyrutdS13P1ek6orH3Kvep0hhxQboUQ//5daXwgsWVorlw/cK9stFvW9GLue72X5oEnZTrpns5uoWPY/gzwZvgcGQjE4+CHBLfiKrpxHnVNiKXSCp9jTnEEErAeIRaNHQngyxpEfgi0l9zUttN6jXqwCtgS00LCPzAYkz2gayJdPr6Sh8aNdJgmLJ2VJ5elZ6fXpNJLMSx/0sMF27LROro2ZrZ/Nmq2cSDo2cxDoONaAPOdoR4CrNyepVTkyVqL/7FbfdtOFQXyenu8I7/C4uO8GuAiLqsAes67a3RBYmlMXct3BVv/uUPd/B2rdU85VFnq6rO1FeXKSzX3UjR6f8sc0f2Z1V1FkiUBJxVUyH6S5H3y1q9NW6yGhWM+UZUGiMuySUUNx7f0jddXdI329TWM1lT0j/3/O5RXfXRQbUlLNjXuHNirLVvfKwvwElKNtlaBHXvYH/M30okFSVhklBubOVJ6VEOnO8mNneMLo1OGbGF89V486C5V2taenpzm1rKgmvukT6iG/nW6a+lbOwYH6Dqu4zcIBVNC6YD/as+2AGISCkq4kfL9KP49Y7CkkFn92R1cd9UR4eOU9K7cHzXF+5rgYEltq8f3AuDRXqlnTbrN9tGvCkxf5AsmbyJQk/wUZJFdObvHAyxTrI+3iltIEuinOcziRKqvy5JI6/vyf73Nbe86kt+BmmbNp68DYpNJXqZJ8ElXGuexZbksIKaDWz6osPYVWloaGhUU3u526PaopOLHJ8WoZpfvmQDxVqqiS7MrW/ccmYA8mCmgtV1uV18nX4wP+PoOTaIXB6PpTQpD1Q2N1cEfyDVK+D0/3/5n9+Bsh3qRp9PAF4ulyvcAO6UxmcponGcK7obKLLEmE14IMjxQJhFOXoDQlL+QcSZQlfH8H14QPkwhN+jfFgOx7yZ1TeTOh59P2mxNbdnrKRgcCxjKHI5dm4op31U/bOchpOZvIWMuaO5HhTtCENgbNMM1JzMFLEyGBeMthHOHoGwtAVLqIFcjlYM+QxNjLysABS0xJHpfjcDniUzPq2yBYBox4am+eDnqolgwqxfQCkiSQjOl6DmoBqvFz3n4VtjFMJpLQLjceNUKlVJ0M+a8/atgEXxYQQRfK4cCqqxetWzeL9aaDgPVshCyIk1WGAgibUJ4rz9bxhLOL43Qp3O2Qxzf0w6YuF5PD1sacT4ZwfOmLPFUF3FheGdYVHn+YM3aFF9Vvyn5ifu+nzxpdM1cPG5E/w5x5PwJq0qgj/YW66iHt6ngEuhzYVwbh5W1O4H9D27dwX8yQc2P7vKW7l6pqJXIH/bYSmDTE6PnkCp3jsGL0E8aPueyiwOSTZKEZlBIOwVC7eJQ293Jgd9GaBcvxCBMCyoKDfUZutfoe2VbJi2tH88XjVu7bwS2PsZPzg/mJWYazitHK877ErhruypWvpMj601tKSvNLZwpL4flcNb2cSQIaE9UwknmNjootlwUkI9f4qDxegLuWRJR1YB/0jeoJwqhWG5hZlUpdQMvsrg5IKSlJz+9oKGsW1TPQMM2hQKM0cYOSFVMTg3tXqk9d5MZXQs+lqA3XI99W6UZ2qxsYMM1h8eOlKQX92bEQ5D+uGkYQqajhDPtjjGchWYIdIYZqii6WwMZ+nRhRUfaSzLSMaDeSkvlWRa9aXTtP+/JH1jJlcpDM0pTKOuaMqBoEEBmVWQD5xfcDhdCFRQ8F8IovwnH7g4729+CrixtOgvP+7P5M/vT+/P+O5fIH0gKtwG/En9GfyN3q60av11Sdpz5zLkxoGMgZGBTIGWqTtG/ehAhsDAV1W+RjBGZHFGbWKiTFZcklGTmJBZnVeykpm5sizjn7r0+gca4hRljcYpN+mPa1WMn43TVoyroZyigFCdvPbrGmNFY1FjO0cKlXuEDk4rq5L4Amcvt+f670BaS91aS11aLV16g7/llaTBYAUCBhFvkzlZ3Ya+pw6TxAzdRdvpH8irKhjDVXFGWoHG2lzM+jA4zBCB0APDEUYICFwDiEoVVtVxko/gbMTIMLeIyf7lVexSqwjWjXe298DJU7vT4PVt/ewIZuJSnC9I1AMa72fUbfSNLdpgCkejRzimm58HmuBQhglfC7xFWxgrcEEvkFYO4vr6ZURxXslS68qoK8t5UTfha3k+lkxytRCWR7t/vjx2vWLE1+MIAMAf/2wv9m92fz16Y7IENFkC4fodPy81udUGjJK2nrTG2abb+3pWthxYn9gAgQaOXhmxvj6J0V5eiTE+fukJme5k7mFho6pQpcQDyjZcZ3N7CdKJLx7104zD5AbGsyMMWMGSPCxMhZw8ollqOgiouCPziYd7LoxCvMmjh7fb1dNjP7/lb8+aDJYy7YM2vmM6ZNnvFxXufXrIrLNj4qD3n4FBjGAgWErm53ciw0XD8SK6GUrm7TPpC+WlaQszU+lzZWVpcxDcGHHRlAmZk3q9z5XvUt/sHsFk81uehwUkvycppNvCzsyqEzzK9n9BJoPWYQ/u71DIeaQZEzIv5foJd5FrY7NbOJ2hKXv4bmmLGJVDLnOTMS1CXgbprxOfacSITM1yp/5GQQVPBDaE6GkbQfVlSkvM35EVIG3NayT/OBm5V6NifssabhmmWM8a1qdlomNl0K3qw6ZYG2EJCv+BqVW5ZzsCkbHgkc6uov7Juiw1EmKH7L7utkXGji1JsX6vpjPL5gjDfj+uFfFjL6Eq57dsTyy/Szlvj3jFCAXmdkXe1f4YeJ9m78nrlia5gEIlqOAoHtDEI364c8hzwtGIF5wR/Hr9NX+GY7f+bldnSEJ0p9dKqj3f6xpS6qfgrvf9579qbidu96/2nSaq4LgEg5h/mqTfzGxG/y2f7XGuFW+WUp1e/tx2HRNx5dkcAL/QcXGih7Pjyax5RUWCjWRJnq+6Wa7ctzdHnoI+0P9h/PrPcT/S/eN4TXZ53lljaDiQoxGYnplNk/vi3ktgc2lpRlEDdCFWz3zwR0F84X5h4veCa5G2CVqRlQl1ZawhZs+ga9BOzB/1euS+0+rOqsvQ/fe8FljetIsvwhgCqdjhPOZGDiWlsaX7pfHfSm6AJb6U+lU989Ga2eK/i7dYaXvckY6rCJCK8DYFYT/4ZXR+79DuaOvIZurwfO1Y8uQp9ul05MwU49of5KCwy6X1S1t+Pq9Ae7li19JcrDtd1mn2TnbpaTXrQLfOSuHyTVKLRJp3gLFw1acqQVMPX8nMpBZ+nJd2QqQjYwxOtTI9OQCk5uEN8tbyNKbz1Ef5SQbH4Z6GaAk6myLVeN7uvBXURsKE3EK0mso/MBx46BbOK9aqpvb+YMqPfJj9KLvolSdtgYVbmUx+4fJ1SotMoZerC23FdQWti6eLbFFSC38LQSspIgRGWvWB7uD+mvyBfHlIJbDerN7arVv36//4S/iECCUoY2VMnf3J/cl1S2CrWDyPGcyb3IciYmyuMrv0ZeEN1X2KgfCE8IuIl4Pz7aMXLmVe6dtzMf5NA2ELnI1GJsmZ3O3GKvn5xg44hL2MmuFmeq2KNB6lZNrJNTeVmUOcaOcCTrKujhZuKJ0KUbiQ+LEepCiX24A30zfkZR2gNrG8mfn4qW0NYIpIUDK+ka7nGwAHcAPdl5CHyzhYepBXkKfdTgrh2NWhy1SRTR3ZafzNZFIkjbhaVFP6UPi6/uqRrhpOD7UIi+VuNFVLy4bbWJk4SflCKW+OUku5SwfvGN860b86vqDFI2aOnrI9tQHt7R/qw7D+YaJKS02roKmUpanMrEj5mZxeQJcrTZ+Xka+PIf+jBneULWRNo6cVoCB+Zymt1bzHncmeoXu/jUJ7xrosxNASac6zAFPBfn/iY/YOiKQMUxxnR/jCpFORwXglnMHrMVppOSU4CMyEY2dUoqa2xp74ItOVNWDJwUHweE0VWBpY0JdtkX5xZYaOnWWKYWVlgQE67wZ4FaaQwBwHpMNqdu+F/OFNe5toI2eKYeoQFk2WkTJTEOA0RLWGzoWSyUG18VnQH94wHJQOZab3hfT0WczTwg17oq6QwSjBExQRxRnEJiqbrqsjl8AhtomU11CTJ/oPIDGFNJSgxkEHqENcByqk7CmM7hRvBx08aWdplxDE+HqLojsk2pjQaFLpy3OBpyJMXsFhjD6PhT+IvPvwgf+JYIqBmJqSgVjrnvAnUc2k1zqPtc7KNVQ7KLa1tZUf6R4/0NCePH6ka+5QDtBxk481HUl2qb1lCbcMUbzLEdyjbcxm5Q7mUKyrdQUK/1md6oIeIaCc5OXi52R6HYZtDdEQ9JCtfd+CHAs9DMR8aGB0KxYMlbQXlFLttKkR//xHK0gkQjpVxIwaZFsWH8OEbhznYmFM4uR2iDY0Ng2yZflMKsbBcSJ0kOOjAeuzljPeSp5JXUnLD/OQitfDpKavziVvKpvxMsL7AjWynh0JcXD8E/vS5Gvtay0AW6tndC8RCpG0E5JSbo+tEY+Iik5tVy1TkMm+Ii6Kyay5oglJIRWPLFhOI6/i51C+OPhMR9Kcqg7YP1ypjj20T281tTYY0PRmV5oFxxz5qzLTCV3psmpSMq/Ijvu7ojqvWx3QUeMrMj85bpGQDrpLVpHQrUCQvrp75Qiyzwedjmchxx72+3G1ZBwohYoV5XDs/LC25faBvfmvPmBee0w15ONfXOA4srzmfaCakJ9MQIaKiGqrFqaHE+Yncc0xukfLSWUe5BKSFPBywAo5/uJqydHy/pJ13clPEQD9FeN5YjDfyid5D6Yfd+7wMLpKfokPlRhCJ2s1varzJ5bnq4n5KA/27aXLQ3kSlRGNOOiIDk+bUYRA25ojXxskiq9tXCsGNq4r0AKP4GlZd+Rtg0RAOMpe0zraeoRqvNRYpXayJ/hr3GrwMYommqWKRMgEiminxEZGSdZw23hJ2QsFS5QIuTI2iPFaEg1MzAw1/8tKYbtaGLgqBs1jmcvjuOTCh61KY2OZzRsruFgASVTcsv81DY3NuGA3W6gpqBmHGYp6YcUxCE/pQP6d/p1ektu4e3k6hHhQ2H9lNZmEmLGBTcht8XTeDjtkixqxHH06H01yhpryE0ejqBfug9OTwrE+AcBG43lI2b+BCCgyGkBnhc1cVY4Zk3LpQO3MMMQqH9hV+YgpOXfqOYx5FQHmcz1WSWLMNXbZMWCchZoJ/z2p2asTQ6OLyg+uzorxpjSgM45feKJm7OwqLGk1hQFrg/e0GKbs0jUhxZS6tar+Szp6XtrOu1ndjxq/7Oi1qO5rCRlZsL4lb3Jn6C05/MJLakHqQG5PhpQFAOXJK9gMMiOZBh7Xbu6uVNCOPqX0sLYI88TbA508OMqw4kxU9D+cpp3WfcjSOk9NeyXvRb9oB/BimTL2lswo/L2TRYS7hx3Q+ZChd7fBk5uCFrwGPm0Qkj14fCh8wlMPKVkr6f70qbmjPyQhvM1vtgwNWJGsu7y8NG81/0Dz+exuY8+n3re/0uvUvyrjEgxi/2mSfbNGsvxJ8V5x1/LMi3NLG3Jmj21Axve6n4H59PS8UJ0V45/iElCMA6cl1sW0dTsiamdQGlF6LlGXUCkdqA+yMGou98xJwkfUtwBxkzkJd8nGkbXZilv752d5eGbo2ozMxk26cpOp163VBX7CXA3CZzNWS/1r8XcOC6LF0uVSGbHs4KWWKU7PmQZN//1T0GbyA2+apDXxugbZrQep4j8aWgjJIAjnfoxCduvQm4OD2wtk0LcCf/WHfhoRcanjYyON00Oate2X2LdLv3gsaXTIOGVY6L6L64dZ1lkm9At/VpoaalnDQaYMawGfC2SQ8Vb7ID3SCp5AZwh028HhgNje+WY7qmIY7AaO7ZlxGfu6B5kBzm0ZFvMws+1XQHJ5nMdDL+LKPJI30XmMIklG3/oQ2a1P/rvDgz9zzWW7uIV9XY0h7Z1Rs5KNkvpP9aMbo9hW90IUYIVQ13/R79jiOEK1SpnUZurONH34JILZ7VWSZ+zFUh0GkE7UmTaZYoI6YWVVEM8b+frMBGxQhv25DrrWIX+uoPiyxnXCkNPAQmDnDH9WGsHhgDjWg+r2tU/SXzvXPw/Ea+8Mdfbpcdr6M1ATLjWXdU710e687nTZc7x11dK7DzIkv9U02qWzmPDsJsmlNoxdPD588+4FAc5geH1mdcbtzzhVU99T/RGfvw9SczJ81R95aIWGxd4bGame7pQ86PxYtVF1iW090dSqP8+IJzNyUGpvjeEKsF81Nvu2POE1UOLqIViyKT1Yb/wyDpGppZHKOq8zj+OxyZUnOeXcEuuEYqyoS96vfBkfX//z+nOp2V1jY9zdwizUYy2wqbrrsb+i4PdmL9cUaJoTMTgS+qk4CeMrHFF1lhNP2sFCpnhNsOJVJsJBRulx04/6M+fzVH54Z8/29ExUV3RMdce6LFlqJqqDHfvdou9tn+PPSz+d9W+htvpV9EJrvN7sah+lv6nxmphWqSV5f/Wem9zi30MZMYLcErXMlETAI5k/8ukU9RVVN1U1rzWVLJlWcSeNaOAa5tG60uId5uZ6g/13iBPzFaikFkiVin5m+wcMa9x5g39Xmp6eUQZTAmi3TgNtDztVwobuUWY8zGV+5wp/Xup1lrulujXJlX7xCaz+tQZx+rHqsu6p9kn6bI3ghAUYDRgLkfnroo39H5+zblf3KPO1D6Q+IuTDzdfx2WiXEZG+SdhkZDBtteqg521QsGr1YBbNZA8xV1euroXBloarsu5B9lhqF1Ag2JOS20iEDeh7rDMtK/ETslhxT9Jp+8XKiPk1d1BgULJlH3+RfO8njYqqliFsiZifxjd/PTwlhq0Nzg831WlAwBPRTHphqgfPPeV0fOw4DGxuHcUQs5HaLl7iQvKWCAF9SFI8TJz2+dvP1G8Q4wcndJuSx/NbDvB6qRf/5w9eAtbOEj9Smap5dggRZOYsJUYFA3+K0NPeSpC8wP7qtwXb4e+whmTl0OYA0pKKvbROqf9fQhYsXJ0DsXHdvQGftL0Jn/20Z3NPWX2mTT2nhJW2/rUIzyimhbfIqbZbaZNlMHMn6w3eCT+ihGr2oHkwEJiCdA3mkZ1+P6xbHDmuf8Ps2MewLVnQzP1wI0vyZ22rTSqzOe9uklxey+jF473/EyhiUH8o9P9uan62t/rDAC2/uNypQV8bGzxP/gX24teDn8Dtiy8k5AKG5o075I3uDD0lwd+EECMF4scsrfOUtMuqMJ1pQeVoLPb15A3qGt4l9OMMYiEQKS6A/ueeAoJx7ibJFbZ2HD2hlyhZ6N7/ZhLI5fDITysgIriHpK//jjF74e9Mf5D9f86tayGwQ97kTt9TIoZGIWEA0su3BHm9X3qO34y8VidFvHHsw8znunP8JLJsOZitqB7IRZ7iLeldd8a+kneKSqSRNGfJZa0tA49fbN3HbK2zlLTwY4Ov1t4isZjA0NFTzhLwgzZiP1nnRrEYE13k7uqeC07/jZClP/twTj2KmDh9TvtA/nhuU1dQhEAuJknDyKBy+RKwep74kby9ub1qZgKzYCHWL0ztvhacm0udDUhPLNKlfnXMYS416arDbzZ1P1mgpGI4mN/WueCn6DXbcZN9qegZQHo1mIr4/6xv1m0ysXH20UcW0j/bZbmn5FG9Ny2oLUfpPVmnluGWfNnKaARY8WfUrCNQSNL7bGhZiX6b8Rzv8O9KP8lTp2E3Jk4lY/zX8tTUd0Kd5FOsqTFSwkqqZyhuaPaVJJt1Q9pWooj0K/imtijIGmos/hq9RR5GngTCDr9CVVeIcuUP61YuvuODXelDwca19LVu/6j4zsCBRiU9pncXL/YOpM7ZkrHXSDLi4tLp5MeTey83d0L7sVJwQlVvwGRrdNL7K58IJxzWiOXdDwlm+lOF9R2/RlqGQJPSy8xIRw9vgm14HNeVwfLe2m50DUzfRRM9Zes5kxHfSDIh+DihP/smdSZ8CptXPjlKHJ9LCHNN84y0bVWy14loXCsFHZPNtud236viA/axjfWRlJHEO/t6ejsHxvVf7i8/f7D/7OUhyfY/c5jLoId9eBzXhUFh5+ZpE0kFKaHULrtsKLkiD2acudPRDEf0O0nOicTMv67NmlrTAHqzxIWR4sk6zA1ECNndE02ClCNsU4MlO1f4d6Wfm1Nugj8Xjy4tPoqLcMnzSpweDYxodIP/RRW+pZo3rvTbG14S7z7YTptdrryvy5x6pEEdLxCc4p38VPso/VTTptLybsOQsZklUdPbyZFAcAqO6b/cXzx5+Pjp8f37QbE8z+VCoem2q4CabowMduK97dw9XRx+lKt1/3Bs3vxcguJ77Bg5BoG6yfK4oic5ig5W9bF1wRPsOvQsHW2gbvpuvkxf7Xs7uuOd7Pj+ftG8+2ydZBH/5eQSi1J5zRzpNZtsGZ7v/nYQaz52BnzkZrmyar/65o8AOfAb18t7K6vzwFitFm9ZNDsLOwO7ggQjK0xa5NUwxngMKTgqbnpvZmnhXtdCZ0VjdpKu1lCSj5sLHu8cEt3/cX/51YOtp89XVwLat6Bbg5rK16Dd49UVQfzE6ASe28cRT5j5wnVhsPJ4XRN44+QT33blUFfzGRqECu5z1ZtVJ2QX413Ourvn+99eREd88aJacjwYNxFmV3t9v3RjepIF2Mo5OmqE/ftksp3VLKt1fuaXmseHQfD+VnjDx9pudEPhhtmDXwnU/J0iufDcIgdGqvPrj1lvpt36FIw4f+0tv39nesblg8RAYze7YLwLOJmzdlTH5rt1YkI+bgHrpui5xQqxLw6O59R68yUw2cRt4iPXORNdwKF/E29nL2gybjE2xwuFZ91S8Td14GT5P/x4qF8cFwXMizoaTBnMr24nKu6drrsuyPNdUgTBBREw4OaRqNr2uEMgJGJFjbmhgr+5JGq2Pah9CTGR6PBoiJuNnXmySSRnZ7+mxStD5/oWpLTHdyRewWOZ1TY0xC4ETVbZXnmnJJES5YDwsPY0BiKTqfL763gFy0+CQuxsQ1lXFHxgzgieyq46YH4yfdkkkakskbq0vYKX53ssIYzHxonKPYuOV9lcUtVOHGqLNyo5/+Xk5sq9PlK58xZXfJTFr3viQ17bVneW3klJpjS01yW4y/8k0DCROrsjD7p3roYMghhUwtV1EYI4WdeoYC8YmKnISU70iq9rCgNhn3HT8M+0OsHXlDe+saF3YvIPCX3td8kPikk1FUkMkiNEWnEy+uJEzIWhaoJwFdsM/0BPf1mpwGJTNiWFNVExMwvBMCWEfSWzR/Xo0cDG7k7ZpTk+5/jqvXmfCa3+W6mpU3BzJRsKgAXpjtwLgPlivgHJAOlXDv3xTbTprxafPonX897ix/EFZ1f63qLEG4NBekoR7xr1TWLZINI9SZN2EJB9GiHYg5beTv7PlDcCDPB2EoOYxByMswTMvMTp+hMyGOSmMBXDUBwaIqmJQhHVYpIV0c+K2DD1YVD36pWPrK65JP2oMu0O2BRGx1qfux7ArUZbBDS/WQ2+aBD+uUFvuELtqsKu8OF0l4fTPh5+UFqGw/VipUGHwQZRVZqg2d17HGG3qOYKaRYwXzAe+6xGRwc0h9ZBjTpodLZGbTwUP9fkEmzSnJU6aQHs+1G5Fr4Kfw1sJibv1QOC0KFfO/86z/m5OQAJVfKbmEuAKXT6fJcyQVN3QPOzxo1kLF9Y7RlaiN2l1TPxApcOpubR1K10T7dQaBIrgtzDe6kETv2/Vw/gOTcMMU+gurGyMHIMTtdMOPuHFtLBsExqfex4ZO99Qeh4MeZ83LiJimqQRlKPph7yS/eG9E6Z9ETUmDF7TTKy3bkWdD0tQrubMce5DvVBbfiTAPjEsGyuJIXJOLx7PCe2ZmweKyqrrkKxM3XKnhmTC0kugZmxF8FslCQ5javZoGv/fgC6XyfdRNSWSEe6z6dPtOg2/tdXiVh39zVMwYWQqzkr2kxBKV5687wukB1i3ZvfWBGABa1bKBvx/b0p+gorHtMbLggdS2PO0DAMZRkeZioqczrIYhPR76IYVWZhD36bygNxb9FdgmXVlFfLsfOeYIJmfb9J82ZJQ2jNknXQdodWPS+tO1XNQmpRKy4/bEsnXqlVMO9hAnXSW7C0WudbMVoEoa1izJ4TOorHnFmhp3vMW7kJ/YvsqWKPBDeria2RUE2rqrCpLU55IfHaVf1d2Ls2v/iXHCwAWAcIOFyMKgASZFUhsQMbni2rfkp20zWaObZKLm0pj8SRANQoz2TRsZl0iKpuRjY743ovcv3Hoo7qfyVxEtL5qr8dSiuevqoUN6v6H7a1A9TdFzXhvcWxVcsol+LuUwbnaxVppdRWuIF5FDCAIQxjBKOYxJyb/VC7TzHIqgvRAjQfRn/7CNrFopY+ec49OU1wMl57cFKLk2JTeiYQdm8GCJc+w2eiZduRo1U1a0rpX3tDEfUZWrqhCLwkwMQ8FK1VkDfl1BdzJ2LBvJpp7LVmMT5iqFcw7otcsQ/5PNnGJmZh5uWxxaJru21gM29VELi7dOfu6Uo3zWwgarG77Fw5efmhldNc7pkfe3FMc/4uaE/DCOyhFsaZgiaKUGS/kIUnDMOxGXpr2lcussyesqOm3Fi59KPmI3cDNBlkOze8v0k/Pj+sahYjxdvjC7objde69NDLrSSby8N8mCouylDT2IyYueak4ZaltfZwdta5jaeFfpj80usn0sLQ7LkXuLeeSEnkJBzx2ikVl7820QnMW1sAkVR8XXOnWazFetP478FG1Ev7YQQ9pjcDdZ1mM3uUU3snz+9x0jqMP0tun+LNJSnoklotCXxGgtmuDyWbbuW9qAEvEDGCWJUoDz0T7VkQ+7QxCqdoqJDmoweTVcL2rK7VKfNcwQRCxlO6v4fnL2bkr8ewBOg9vKisZYsWRrKd/9Ql3jQ1EvRgYWHj2EizbrrHms8drY64mLcTSC82/WKxS/uSwrq8BGYqOQAbPpBG+/EKpT4txXVn2KeE5Eewpxe5z6kuvBNaCkPNZbg4D5HLiDs0zcZsgoL36cUpyd3zpX1mWeRd2q/Kt6olOZXLSO1wKt3fWme1TkebsCz4KFa+mLWhCZca2XCp7HbL1mIjTuwXyhnJhFprkEm1Vo6F62Vi93q4UcbCjTIQbjZwbbMc0YsF6dZQQ2lqni0H37PtsHCrES5vTQq3G2y4XYKS3TvhTgmHuw2ad0eLwleEEcOwLhp6Y34YfafWVpMwGbcMzvajwWoWSzSiH3uZhUF9XwdfzezXnyFFB7ulWARSkkSKDkJKP4xws5w7vJlsX7514xm8WVx1rf1+6uIiVfYqamV5A5ui99DHGLxiRbQyBnFWxFbG9KOSw+sJuOUVq2YzY6RfIEUgRcB9WGCMf1cM3ZdDlGfkC8y/EJ0oCA3htfHEbGFlISheyfMEA6Np87kUsdD1RAjwztQfctSwZi5NJUsNawwZZgkgS9h3oJQtg3pag+5iDGyMBccY5HosTWIM7Luxy9ox8faPNsn/WBsRtodnt7a3pwe6R6MedtvqfeHtL0PtNy21YkvfT6uQ5Y90RS2aqCLlXd9KwrA7boLufvzdwJ4hQZ8g5KC4vCI+cy5OTW2A4r1kWTYkvB7wM0UpGjJ9uy0uYJF09ypgQIHsj76k/qze17P//A/+xP7G2Xf6L767+Bl/vgDsvSdZAKyEe/o6fgPOkT+A9IuZeShxQovY/6vGawCWAcA+OP9eLiYS/iT+vE2SWOmi+Xrx/49kFZiDGVm6Vf/C6fGcDpudmMYL5h19HXwt0h2nTuuaV5oEp04Q73RUJABj9+0gp3eIth9a1fJK99kOdPqcRkguYTSojiIHO7L+OogKZtAsP6FxRwp8mAlCaDMY5KL7aNrSXKZA7pHwYdS2XLAfnwnLzRBo0tWJPuFmG8WGZekHl4wuFvMwQdRRAD8XIS++huvFzLEaAsyY0kYPmu4huRlVev2d4/yVCM0Meuyqq5d9bifxa9iTYgiwOlIUtYIO77E0B/a4Xpo5drODafJGJ40pbTTRmJDSZmRaKNVT1By9RzZBNOhWHdP0oNef2sx0lAGtpHs48hxJJajDnchpLhS2NzOH1w4Gy0zp3NtJNe99XKcr+OlVp/GT2YNix10wtUV02HSD9SMdP8OMRrWdY7IpZqD812XndJ++jCl8Poyngb4z29kcffuDXZsp8ylA86nvmc3ITXkG5GbQndQGTViCYR4WouJZGLAQrZ9CibS/lyILm74lWmFMkMGPGhO0/2Zk/cTUpqPDFKrXX42QEWAJK1YMMzWWHu1nN1FmJ+55i2YtDQb9D92Xd85VzgxjShsdNnOQ7m0iAm0fkhp28viz2DC+orEypWWf1wagkZGm37eTMiP/It/PkdBn/OiN2o0nwB5dO6cd5EsM6/3FwZzh1indX8iXVybkfz1393yADcJrQz2cEOh3Bn2D44h6nOf6DMSF6rOS+JtBI2a8d1nb/RL2rXGonu7DMgY8iFGU4LaAb1rvxiFHU3i4EJggusT+nivJ3qo9E/JlzbtNsJL4rbEZk+QzzVkibbvfEio1nJHJ+QbJ4/QR2AexK+WRNaR8lyIeiJ8KW2i/nTesT/bdMzZ8nJWeKGJ9m5whZgv4t+hlnRHNTTv3rYS1n7g45Lqwvtspg/NA/XV+m+DSkOwskNghJ42HdY/ZgJxRedkL6w3yBXgyp7A5z/cd0sfbKZpm/+Z8nvTgYJV0uJ5eI+eXr/Fear0V4d+yYvneRkuVFtb1dUawQ5rBeiW3K5xpQNUkB7H/pgSRWue34Lj0T7cgWsyeFzPBPuN2NCzg3xpjdBkhZDviR4Xxy+UZPx8T7T3r/1+ehkGJ3Gf75PlP7becLAmwtsP53NDQSNeeVoV1w962ZUrTY0FngNh5szq+gxkU4r5HcEHLTwVkzSVDnkm9wqaC5g6gsZ/psEUrGEDBO4Y5UmNRIXARWsbmaBqrS8suPczTyyvE6JrRxNeL2kuzR+Nx+a1pRgGtNBiE2ocPGpPSxO8A10uzR6NzKX+0GwP41w1ql9EVmdYxpfv91IRQ2WFCXYDN2wQtStMRXuJuf7dzRgrb8Qco8itqMKN9YArA7LTvKI/l87G37J7NGM6g4auTrRTMCPK2GpoqmTNznDOCGbPvlPuJQLPMpgr0Ik88VreVMXgGdocsjtavPBYagvq2yYETQyc4m6XLLHA6XgR4g+PV1WwzMmbmdYuOZO7ZEwp+RxVdJRlWbye5IKIT8gtkkDvMDxpn3Smfj73ll9mMZ5xxMx3ITcGA3HQOA3IDSvbfc/fn5HVOZzU66MRYk2CkMHnrThplZ3kumiXsbNt3rWJL5NgZnzY6Gjqnsxod8BNzTIKRwuSt6BgAiTnLY5aLOuexRaPhzk7MBf4aUD4ZzvQRO7tbciAjv6bytTglo/qNjE3VacnLhR+OJsP+pXNHv9+nqieeQ9Or+imd/wf6B/L80YtMr7vPyMLjZegvfga7PW5b4LXs2D9ceTgDMzrP/aeeZvcHoySOXDAmBbjcHY7Gkvt7czTHVy9PVo5Mzk2akOKo6K9KBw97aB7s/UEzd8yY43CpdpUy5DNlwTslgvfHj0rcgf83mwZPa2GktKGqxHF2dViMKfU7SuBAG8b8wvMn7CI2ebOcM5rziZQPoHXrTSnisOgwlwEPx8nf3t8fDuaI4gJ7Mm5x1GISEUW8kU5U3N1tDsBXL09vZB0X22tOWZ7g0gALU8sOLxjfTht7duMpKTd4fc3jVvL8M+TfDHWMA3QZ4bA3cDTH5nBksn2qf9foj1J8JJns8C8yXlrH2+v9TTf5Lcuh53raXt9wHMzwj1+QXF7e4s938udCSvn2Fm9sammzcurJD+Z1vj+vomo9iSav4rkv72/21l/u+Az93vfzjr0+bqvba3pziZsG1RfjjR3FWyN9KZ+9dBlxm8MYBoTTTeCfASTsnCd/3Ei7TtbIiaJChIKvKTl41R6vQxGxip8/x6QbFEYt0ZK1nlVAqg+SfRK7HGNMCQOVbEtOofghIQ4u740LumM3Y36LAyEJSh/mV/O/e/TdkqRkSKAhCCP8iDgInxc50YIDlGz0K2EEqlf8JQI6B8JEbrDwIN2S3LYgvYdDI/w7REpNVWJrQeb2DATQYIyYEyuaxz24Tb6/lqg/mDCtuySpS08Xg8nfMWlyR8jdTyGNTBVwL3hU7Evt0h6AtexWfHeJsWnilzRNvtmMm3GT5l3ICR/b4S/DK1edIFiT8mCMgRZH2GDvCP7Ljagx2cRaVOpJZvkIscr5aG94dRzTuE+ZwjuP2drQcQHmHQB+C+1PCZxnMxaXhpGkD1VvVnkOIBNebPO6qs5fUlVLkqh2J+nSfCLUSrrAtjcmBxRwQkFhj8lPkN/LjBgnwawu+5nfvcoF5nm/LwDeuCFNdxCjGAsHknTAct4DW0kJJwt84OWFVKuavkQpWn37Dp9i+Bm+lNkGKHPDPlb1z6g0zOBIgdvjSKboPSvNloLv/FceC3lrYxr9cYevr95/r1yAywgg67/TjfA9fhPf0n8QJbi8P9+OxfeHpkBsgeU3lCD8RpEL+XJ8Myie4m91/b2MtW81YU48BfW874DFPPSQzlDNA91d0hg1wBut87c03Yw/Y+OMU2zDnMMwtj38qP48DZ9U6xdQLWH3gv/FJyv+05i75rPPaJG+me7wYg+HIwDl4+PiPcqa2CJy96acZVBidKwhN520Sm6UEuAhGWE/hR/e4uHuclvTsdBdp2hIjV9WWat1+xatFcD+GcB294yye/BzUlTWVuxaB88clV6VyldquuR2SQ0YumHyhiGXND9qEaNZTsHHiIB2Lm839nJ0TrQ7QYw8f2KjipokFABZkWLigVTDRN47HJwsRRgH1CT7NLMmcVkS7kp66qF7BkBS6sldUNnUAjIXyTdCkFEWQwSBXALdZJlETyjD/sI9KRPTc1KtQ06QPA3WKSsiZr64kAjCLwg4yVW6SioFlsSgILEHUwQB9agiwVLcH2hmi6qWBYQLIt5boc0nVqBPSlD9N28QgQJmt3qSdA0YVUOA2aIMXSCGpoI8j3kwuS35AA77VvhnBKWm5ofO6Z5AQZqYF9a6oJt/UagfqaNWqcvoY3BIzij5DXQO7CPfD2W7BZVUlPRx/FbPwLHR32uerUIq66muHZTS3nbnaQxWgH0CkJfCyuxGjg/uiwnNhJSrrmS4t8xGoO+2coHed894iqYnAuYzmQkEyZMFHCkybSqKx4FMLW0ugKFJEQLO1giUCj22seydjYkBCiMb1WIETDSKFC/Kh14Q2Oei+5r1Qykl92Zg40KzBvNjLRGjExnXwxaCsSkBi+qJmQr4EFMo2RUbCFz0/VXDIUlQYBYnStFAUEnkbGXrUqo0OHCsnKKyCKODzwXEkOpM5XeACGiZn4yCR4SlUBxtvUnL8hYnCERoXCGg+MarASVGJoZYNQBV1GoBHmdeH5WFm/yRmGN08IWNPN7dnwKmKJ8hLh3Nwhg7TJI8TP0my5Yed5cJ28nlT5BSduCjPhM1G86w8AIJG31/pO68wUVjsDxjmRI9LTPDIUNaoX4ENO8f4aLwQGMSKGVndokUBsbAi6EqDfoFy73hVS1bHQXmXO+xoqelMMAztJV3r4KL9GkD7dq8KQqiOOFiaIoz2QxNorFpgPv1LOzR1ZV4mMFi2CZNy7pOE0dAQruAfkOPXYLgQs7SWsH7JoxMh4YV0eRQ/rBPtj9m91s53bmbFtjcTlv+N79Vlv3HT8iybTd8otQ/PaLUttv9xd+/2+l98oW9CocvCL18/HKHT/CzATQtAwgItGIWIe6CymfHHjldAMY1ScgbNRf5rC1z4KU/ky6n9JAmf8pv8pf5nf5a/7/+h6bqGn7hezi8P3/cWXb74ujyvBCp3rXhtNGTPugvxdfi2363b4lboVXUMwXyEeiDLWzveGnj7swS9FKzWSQWjdJVFZQ5ugSuecnZPgfY9JMGiGADCekxsBp6B4Bm6BzjEbebusynQp147iUz7FE02mtmsIQOnesRYTZfzHSIsHjE95txAQplSCASydIJUthi7h7JNSo1XwyvclP8ryg9m9TMKyyg1uWRSmdKj9CDGp7FDFLqpZuPAbuuzdVBreauj2oK0toUjVZLECThgiOD9y0L/UxcHKZQX39l0OHh7uE+I115UQ5HA3wKdL3Ke6zr9olaY/aqGAK2ValWch6u0DjRGEr1oaxUbZxRygMiYNhK82u2K0zLI8bURbZkwSdsCAUrq4YFRvI0Y3KfJXKHKVgepef0NIFVHUtKWG7fm4oFRGdppvU45IKt7gY2jVDuMFtFT3EoeB+PV+VikzTMUhaHMQGK1+T5vWWUGFtI6xDCOqfBEICt8eZp7TNoeXpEJgG+P3xHK46IJfaKXjUKvU6mH66uaGxS6eqHpBlwXJgkafR7fEu/pn+i/0acKO7bIi8o0iyRqXZmst0vE6idngCEAx7B3Vsa6ZZCAfiIy0n2vep+GX57N3A3d7cVPuk6R3G4Vyyl6j7jWuEuVJwmU/wtMNokgiZ+mrOcZ+UP/IF/M6nHQYFRdx2gmw/iplR1a9IkOqJJA5QlKo0u6pDroUqSky1joQ90XLDEHd4BSU+SjgkfKveO4leV1Kzw/uOsXCH/+1OZ/AmrMPtxlxVpp1wiuosNn8aiaZsvydSBFHRoLYD8CNJZWfVTtmmVaNlg1zos2zSaVTUAOLFcu4tdfrUzMEuRsPDgtyp9SL9J1IWSTX5QnpubTZB2LFIWsR8NnzaRW2e/ArDNk0sEzU76vGr1F6lqGVcu1wNgB9IxA1nGSiwwEWG2QEy8WCHG8dIb0/WO6O3reNG/AMPu2k8RSNKu7efsR59j+2l9d83HLGs/yMh58wVjbsiLDDm9qJZycBjQNgpXAZdlxEx6VJfpMgq1xIl99inA0EscO9xzlR/kdxlzfjn2dL9eTcMbPsPx1L+CPM2xT9Mp4no1F8W8IADcacJ1pm94/vd8TffcNMy3kMfiTEYXYfFcW5aAcDl+UCnuEQRoLjM7M/3mQAl36a0+zGeMExVpd2fo2rwYL4KQdQoj//QTBTBKNU0mMabLFP0CV3cdvV5px0pKf2GC99IgxI2j0fESTO9aLQJSIjt9taWrcs7cyC/JpGUJldKuzgnfQSiz35lZ3PgkyVOgTRTVQ+I9WYKkZsnm/Z5E0Bx8mkfkbzfo3FSTde66Ft08VeJJvdIzThBa+vwF9lySMwkTeB9C1rWmqPxOiTX0VfJRVRa5PR1Q+10JLCCJJk1YCFUqfVLBBTNY2W36QqKObGMIFzYET8kIIJlmK9WHS/W6VFV3rOAy44INXTCcYIQhUA0fqTgrbLOvMMjm0qMOfHMF1DGhVfgoeh4yMQvBDkhdNF9bQMwSfPbDQAT/Tvh7SqYGHt68+Z3T8brfO6LJQz+1wwr61Ll+hVc7GiP0uk8KOvZ6u51+xpbyibmybLl0z9f4vWvZBezfxIVB09T9gZK3kblRR8kbbELfX3LnjMmrigFYBDB3y3ydlzd5jr7jrNPaT4Zw3Fnb4IiVHamAI6pgOYswBxbaNw49jUO7lnHkqW+npoSRhUCB/UK71vPU39g0ZbPptzWBvPE4UCePiybf2m5ebo4Lm5Jlh2i/CzxtCImaVbCIC3y9JQCHw3uSLie7EMeJ1kMNQw4SYpO3mziGic6tshljKuWJFpgHHXA5j8THZ3EbtT2Nl4ydoflCbGhs0KkxUBpfYdwF4bMF0UWSycxr4Zuy9Ep+gCeCgTk8HHDT2QJ/aKubW6iW6Za9ed1uzatb/2r3yCsw8YxBhrThVKvGvjQ0LhzRgQRj/sPgL+3XfT99mlWgt69DuH3jLwHi2t2y+8/UvCnpdz+B0mTyOnzB69cZmldnyvSVKfXYPLG5OjNugjsv/rNwjGoW/WjjHiLh5gpbYVuya6b2b6t/j2UYHkeaPADHofmZSzkMsOLgu2vY1S60ptIsKrX0/nY+PCEE7WqCb58c55LvH0GxzZaHTrtwPA6xpriwKhnbqv0mm1MYdE6BuNPFkJYHVzoK2czi3XZxc7CkFX0wYba9nloHqoA62LbBN+tAd3g0apwfpyyQxoXF0UyeyEOmCndHTuXE5fAdVa1rvl5JL3AC3WN4BLVYPCKgPEDF8KIrnZuh+AKO1zTSTC+TR7iqceLtJ1mp8ej7yvk/4vRV6HZJy7kMF4h1DfRqJzrgW6XqD5xSD+qgcEbV/ASN5+tHpAG3dDZocMJze7/4v9C7b7toC5g2R014s5IzKJN5YM9bNjz7y5q+4p7/mjf+3Rk6X99v+okSXoIRdzqAqZEJlx4xC+bYEh8aDIVMn4CW0uoufRajsBWK0cmw2EoRjcKsz2afCdSpbig3sZ/nmS6nJh9qWeYfmLI8qYE9UgP59WnutBXfxfeD4KSH5YDDkCv+QvJ2xfiFWS+9rxN+HPIGHWlcQy2cQV4juca1TNOGnBJG0SXogasysTdp1ZMCG8YmttLcENGHuBSWrvp3KDbN/ePUpDQlALLCrSJrZPdhZT+fk0QhgBAkVhz0pLL7WAtFErJgLmskOL9DAAXGpjxN1Zf5dZmVq0oEWcHLzCuEjobxuIgR7zogdVkIzaZ+YRq1PQ597lTQqIYPgAN4qfvsytJ+CKldoHucRqejUdrH4lHUrA87sXnux6hea4RmfDCar3GC48uFL4NDCW12i4aDnxZ0XirrTXJjSlVXFEjo63B2E4e9moa2HZHIMuTlEP5QJm9u63rzwo1pOncbeveqTDh+V5+Rt8NtovH2lubt6zMqj188kmuITwDlp0doAIMWB4dZY5lNiDmNndh47CwDJQThhWP/6XiVLLwQroVLTek7FZIBnf/kGqtMWu3cJ5JywFGkQW8yap/iRGa05eRQmVjTvs+TmNrW8mm09SlqCpnTD6T0SxVrSyC6JnWgPLrpMvQOWSlideNQ8I4mWbAOv4JASpwwfm9gAZPcH8GgK9IrTPnMSjT+zBxOQeMUkgWlUclOm5yIuRZeM/hIUo8VFff3ZCfHMcMQ54HNS7HoFmdk12nQ4RGdTgEaKPW6axIJHsSoAUh8SFMdm0Xx8tCcVu7sV9Xbt9QioW8jfxZv4g1UvUrqH39twGapz+sqtBMZ8pTH1Nflaj4MH5F4Q5qYFl55fvQcs2j3Y9guaWSE/g8hgQrqZ7hc8fdSZa3qLGe6b6SK6voBPJEqy+sfFdyvVJ+qfnG/uVWNb/5Cfs7EAjwi/M7V33+PHW9mnEcwk8RLTYF2O658qCwbyRoUyOgQmiJzkL02F1WmWpx9FoDLXfdR3WE6JqOpoClqolYDfFbmF46Mh2SFRBjIgj63VxSSKVNXxe9Hwp9DNdE/wDXHn4CjueR9zNCVMDiHfVR/X6TZgzgItKWCeXyYsd5uEWUFkWOlYZKfJ9EKF/qCnAbnW5dmcxaDBpYgQfr9/u1M6v4hwKX74cHiDEzZZ2oJbJUXV9LP1i9ij07Dak7xZMHY4NNKQbde59Tika7mpkQJuqVwFsOAs4PD8YcKY73HiJ7fOQdrmRJ2xkpjUFn+H+v6cSjV6zX+SAkOqfVNNl1SVM8D+9PCRWB8CgxU58SxaKrDxIrFXsRTEE4jk7gLWCkHCyxYkl8TPJknmqcpXWY80+QoJC753QuRWbWbYky8gLmtqdwgWDfQAsrgiGqVxM9eVokIFAHIW5FWnRkwaUWVmNZ6MMJgOLn7Lg6yV1DHPV2tBD1dKtFOeHuTbdzmLdGB0y7RCN/k+7fM+Vf9807m3OVipWJe/2EQcQ2VX1+d4R9cG2JeO8bvesZL9vmIi8GbzDxDs+DvnASJhCXjCkvkNa0qdDm/d1j6UOam4/NSwzt6TUMwimA3rUmSzFDsxscdvjAv494Y4ZY6KDiQRs+of+ht17TtVUC8F6rdr/D2JlxL2yjJY/TF0gqy4kJiQUV8KlrAFvvW2GXB2UniQzuzyws2TtP4yK2ugHaUEl2Q9qrNwqjxuf6e0wJTZ4Ctc4bS0ecUF8UaTraHnmP7UVwwyr7hZxhVcvabPsnWnu6vLcxzw1i+YrC/fKTBXeIr7KNU39+Zy6VnCmIbvuPbjSPrdXIm18M60bhey6YZn2i0TK+kT5MvIgw9NzFB8h6OJZQl0vVqyvMVV9HUvn7Cg+aKxGotfWpHIJLJx2ilH0LyqBRsWtdt7fRF9EQIKGw+Iwhlgamt5nCKF0rxjTbWjGBj2GJ3w+a9IucVgLZ1pJv1OE05rDn7KCd728mt4cbCXlezSum/kbaSmsbF5WcDaQMfecrTHrYXF1dXa8cHAJ/bfs0PL5Y3N+UFbUNoz4RQXqy0kCVflR1K9UqpZ+l42TNJVhfS5TpqFoP0Lq969UQrDlf3C8plhjWztAPPhcUI54WPHGs8VkZcLMOI6Fntjmk9wMVhPJ1SFObUNTiNVOuQr0JP44JmGw41px8HKXWBSkETHTsZRXxCKJ5YoBxZBDjX+KRIZWJ0tlC2EjQqFajoDL9fMFtpLR3HGWNQtIGB61jHW7lj1pNsmhTiweUUeMb1imvo9XBG60mSfBVJEOpJBI+g/M7MtAEPlNniW16dear1jKsQZ8+ajO642vUw7G/QGfncpsewsI4c78wLMbojoGxJVZuGwHFzuZpx6DtIF6WOpNXtE6Vuk1mHfYZP+pw0Xd/HcWOMTrmPRH3Ey4sN1xo6rpYSytXcKY47MRtz3sI9DSyLcMmLSw3wIpxtsMQ1B4ZoLc96Lys4e9KmumfTGMpnIVhlvbFgtyAxrSNDYbB8rHGc6pQh9xOVU6RDj3yu99TVA0JJVbW3Io2GCWLfUx7S0ebZbuLaBuGhnvZ2Xqfnamse5QTsU+Zn4dmDCCrPjhaqATjQ/aA2gyrSGSgKLkZhUGc/lo4uVqqFD7AD6NqeBFdxr2p5pr6u2E1VRdBRimg3udnV1vH4qGtqlDc4+QKC4Esalxw90QvLEkHVkR5xtAZnrmKjUwrwtNaZw/Y5SLFR0eQgTJhMRTZ4xRORBdmHImstyhdacfH6CQpYHIXaAlvhyBzTfU3wAlSoanCYYjcki3VizgpJvgnAlFKkmiYEHx6VrLcdHHzEsEofbAqseZv55QWGGMNbYszX64V2r2neeSFyh5futVemMVoTRDAYpmI9BYrdinIlIKDFiVZ2CqW/LyoRzwFfKyenMXIQNBm7IFt2XfsEqIPo6CyxgY/VMc06Kx/jnGYQQFUIpc6siaW1LFQoMSYwqTP2qYySa2MaYF0GGXGwM6RAGkeGEIhmMM9I2FQJqF49hkMgHtRSxywBcEAhFCwIi3VodcStizreQCmh6qFkY2+FJ10lkfSFQrZ8I64glgE0VlN0DHbyVniEYPXeOFLMbYTCoGDuawWaEtct2Dbrg2805Y3hamkxbbmd2wMWazKsdW1GplaCciaOdZ1hKCvtu+DFn7tgArqK0XS2t5BaidsWXMlzCAbmERTWFBpLaZFs6KEYNcWtMHJlJ3kedOWLIhJMjDhwEnkXEBZ4q66zwo2ssuu0BBAamHW2WC4BID/RZl14pU5P/itnuDaPuTTwUUTG93WPLkDlj87l4CM9Tonte3tG9kOfaLTceZHuFneh4qwWPPdKJwhPEn8iGYNoRdfCigiokKSsuecuBUmVxUxCCXvO9ZlcaQNWP7xQ6Dc96ykL6inGBmnSZSWaasdLDkI2L61Vxq6KUNc7ii/GencnWJ5dVnbXriedWhVeGUOeizHXoZ/CEOgVVDPBFPIrvquCaBu1aQEUCfcWUTVOTZ0CYYrOe0XSySXRLV8WCI11JaiFNBqGeIVXgRnQx8kInqSUcFvWLMZEVVwMTdQZ0KBOlTo8DLvU1AFBwr2gCtqllIzhipBICb4XEdD8amQkRLxHC86vKb1KlXHtM5Eio5MDeE24+k8rkP2tl2pdUMpyACtAjJ6WJchxCDI8oWRfeO6fQK52Ue2LbyjXBJjIXq1bqtEzuk6ez4Dn0XAyonYc06gqetUFzLgJla+bIg3ubmVGKe/nWmDkn0CUtm5MCrDUS9k25MUhacSbW+68ZDisYJZo88ScIcRyTldS2TgjvMrQOPY1aJ3VGSQB9QogbEKtPtPWvDJPhHtcJ8wRWlOlxUH1da+Gpq0IqcLm7mCbp7olWQ+0a0X2SoqTSDoKg9mVT5wGhtOFutVOBo/1prr6TjLpKIr1zVb0iDR6LCJQnuyuKilHTqcKIEosvI9JOZVnxkA+rll9nhIV5lvu4vQ73YR2gdWsWj8wmg3bXS7U3BprviBYO9dz/kjN5NdPc09bxV1xPwue9LycUdDTrGpQq4sZBh9RSEzSshhhEtJWxiCGdqJHV8ZQnSqTqoL3CfEpqsOD4Ds4NLVeLCCpEOpCV7O5ZYSqOnel4N4sB4/s7mjQzpM6qqFpMpVS5pDpNOviuUa1uEoMnEqV16wZeS3bPnSCjUKt8liXnzgrWAlAUxeCLT4DXmXwVEXi85ADysLtwqEoRmLnNBZoqv6eYzxIRfiamx2uAfSqUdYHgl9kcNEXfiJmP6zzNH+H95T8vVdFsJpR/mMCZjEU4djCN3p6WqRk3gX3GQn+8ddmTOTrYN7YqREN5ydw2R+/l7MyBGA7p0ZUxQJwfCqm+O0n956Dshe+JwmjL6TQN8DBgQVSnjsr0hKEJBXuilcXk5eFKDrVcsUxc6uRskz6fRFu+DB4LOesCdVg0bvVXCKNCZC9eC+RYlunAATDEeMMLFP+5kCeNabauqm9jFPZ3/PMYuDoEMwH1XCjUqxUJ+vqgudS8s1XJdGiRRrIsAiTbkTgtwhejecxyvfGxspQyHvcbnqFUZBls2+oR58ZvQfr+zbEZrnt9p/86OVfPClgKkxotvsf/cWzp//qs89u//F/6a++Lf78Sv5cSa37FhubObo4Hnc/5h3TpEObSaGBVrvX0Tv2FFQQLqnG8P4EAMfPi4IWaws6F2NjIwzlOzufW2YwKPEQtZMKLBI3JtaPALoHlUprgqh0LiT90Z0G/SUFZkFqnd7N827Z5+XDtJwvaYr+PnU8dL4br7KcH5o+L++7pJx91/PNuliMi6/I1HkaD+M/nckFnVvZtd1XqLat0cbetTa1oeg6xZi2RwQFtgEp6LC4PmReFGkdNl37qet6TK37nl/tMlOZryiqehym9h3TNPTNcPmOgTP4GUuXjfTg2LOU1VeJIWwT9iyfmn6xSXeXfDcJ5kfRxCYNiTtSY4xutYJ3Vqmr1DxdO+so/0RqLxi5AwK6her8YoCE42m+Tl/c7qAlbYuhLB2RG5sJbRp1j2vhF0w9PPUQkT7rti1oKjt6sUVc0pUeq7aoSlkgPYx1yg7XWEmXfo8+oN1VR+thDhlCQWoFM162F7SHq2t+e4PFl9m2nRDbZl7Ersroq92OpONWwDVG7SoALCwg/xyZunZNppXMBZDQl+5416ZIiy4zQMTNVILvxIvhw6y4txTVV0MbWT5MlOZVTU5xmB6mZKqnzMCQqTCy9Q75sOrmf2B0dy08vIFiVGD7G3636G/7fqoiIflkuRy4XoFafXvGeyWMPxJpe1ALpUI0dtZRQnwkAyBPrXpBIjARc0nQqBZ+OmGggJRYeUkJDzHGOoTpTDIlQ5SDqVLrQoAVNrUGYLQBuNxuCkReLn0szg7mwKxtAl6cI0+mIRJz8BjgchhB4B6GXuMrsPMrWOQgse3W/PZG9Y7R8q6uMX3sOvNQ/nL/rmAB8Wj7UN6CEx1wQdZiRjVjE40cGEDsC5maqVDdXdKXh4sFmu6q4jEYMQx188XVWtXClb5q5X+1OCXxTKvFtHhYfJ38MmGLxAysbNG99kOkhphLRdmHQT1HAn5KdV6mmF3gNifSrzWBibKk9l6J1MvMLPxGiYv4sr4paicRAZ7yZA88EvFps6Pn62mUnPSFeim+BwKb3gM3WFLHiYdPf7gN7znxNC5oMk6ICttMHAEl2sQQvNJCojSH4THZ2Fpa4QP4PauyadKZ5LYMeQ06uuCihc8E8L7TWFqUCJLjV/vMcKm/kNEPWPMMk5ExCDdhBazPppP9SQTBpDxxYE+zJUy8pRrckeJjlZ512vFpsWVLxVNchcfao8gOIW25dQhjyeH77HvsfkPc27konEofud0UI6iukhsZPjT+q9DdsKl9Y0PEQwaI6mOCw8qM6tNLZpZXNKvf4kW9yGKFRsUPeHm4WhpG0wtMXTE7crMXiwdbVNK35tECaxjm86NSD9RKaeNThiSWpTlC3j7IJcbTLyLC1wZhYUZFvdlq+iUokNXPClXrVZIyAyB9VaLkPQhmy9V2x4mG6fhBfM3MxdM3MY3z/buU+C3GChWO9iPx83OI8A1Ld3ijfDEOQb4kvrgandu+f/iiHUgcAK3pVtGBTdLsYAiFOfhSlAyxrmmHxc1tplr2+ji/fvPZfUYZM8LlgJsUF//D+8P+vfggbNdnw2YXNLJhMJb8GToXcSy2dKIjwTSN7Ga/G+tqNVbVeYUJwOUQ7w8dUnbUOzHGmj6eXt+MV2Nyt/F5fkgT7Zs9++TNzfX++sxyb8W9tOUTsMSitaS7PLgvCTup2MyEVNIkoCIkkwiMsb7ObI0xaAcwat4fzj9hdLVLbVzUmjiy95fFtnTjuPAsIoqPqdynVzuXLRaQpZVn/ipTzx10T2hPGf6DYNaovwwqqQqDA2tuK04x2iflofG/IfKn+I6S34NQw/18I4nB5RfTD/O5zsDCpAVwIS+s72Ja6F9ETWX3g9ykDrdsXAG+ds3hcXIFVvtQQymCwnssfEv+q3Ow9A6NkXXhS9Bu7QJimsKbtS+x56dexp0KHZ8P3oTxukEyyj3ui9qYou+oPV3mnxBSDxarLSSHxx3hRu8xxS6bm3NsW2JqMxbZOVtOUZwOzJbFT6hrSOAkCyyHpd00jX4XFcPlB2Vs4zcEl/SPWB3o2d5cnMS93u93L/N1oPk2lEm60nV7deVaWLbLZ9KW3l64i2dK59x+ypdf8okNvImh6oAPV+QO0cc6N3zoRdPYnDTZVWjGu+LdA7Rxr2QREqoiI6Wjc9a2GYMuBkBMi2jEisdkS0IaYiJEq2BzJVrgftvxfh1BViUZWQq50S5mNMSJ9l1Z4GbL84UEMblJrAWV7GwkzCHjbazvueZKIj9w29S1p3L4ka1m7hr3DDaND+jtAM8GqvKTcAIV/NUAuQ+QPm9yjD9JRb7tRjYvGyr4yt/5ml9L6/Eug9691GK++9iA1GKHQkPwudMSU1icTgVKlk+WkuSsRom0acxP+z72/YNcP9dEa7bkxCHl0sIwCc/+1S8TDBxWJdpMwbfhQZWj0xujPTiWtYU2aUKz5WmNMZGm0bChbwopaEHssnpp8IamriWSzhhCleOMjYcp/6ccSmcyj7m/vzNRPZODxi0eZHEqy6qsBqQ6at/cptlmS3Zm5JPaGJfwSHVVjVNHQo6HMP9/lys1TIf3WPBziFO+TLUKcQGgse3YavYtb98yFtyHnQabp49Z9uhbouo2QYQB5eJVJMP6gfJS0Hvvw5hNTNUTgIS6sXlZN7T5goVQ/ECTRMx+U7Bm7IHzhzNctUWmgfIOqkzeqx5ziC99P2yqbeHrFVx3nYFPdRn45cWiyxnfqLUQeR0A/aiKt1K+fSaVJAmgS3XkujWYF7EJ5Rpwx4aXHJYilVOvpap4DeoRXTWA1n5rWlRyGSOy4CDXvbjNNUqRusAWGNdVwETUo2bYAYPAqdFmxuzz8vgKmK4b3bRlUJ3cksA318wIvqa66R4CIGuvLigbxAZopq/DkklKhYMvbaRvyZlG35YBQJJcO4eqOfTbP87+mgOAhCldohfAwuRfIECoe3ek5JqtDKXqJb0DBYAV7RmQnfTCa7ZAPa2ur9qKCwJImjPc/yX5m6yjbAuHEL6LBAom3JLmQQG0HClpkjdaQzTm6PLUR3MmRszj0ghlpGxugLAuUTiqJx9pF3LmSYaBZ0bvoPfgQEoc6BiYWAmArOgPIdKtnEaLMX5khJDtzlk+r68Y6qab0/ZmsWiWc9skiJ6p/XzJXtwON37ZqExEH7O5essGYqRUNoDCqW6U3fWy78M4xWCgVNBt2rKbfXftx6DyUDhXl6qjK9aYpjXiuEGXtjssnyDkEMtMJ88wtmnc4nYzfXOxK3fPOF1SO2EbzWiX42tp2VnEuEKXNjssH9muaH899k3aPFWtqy29kUe0K9XkgZk/0PXD6IaRTHPpK0L5TrGii3A0jaWFoeJ9xUKko5L4/PMraulVwJXBhY8chW2zvWVLyUGv1iPxli0ts2pA3U2+v2oYYmkUhWsiE3KxELv4qO+45cyOogbd/CKKBouRgnekXdfu3xOD97eGYQnNi4aUXEUwRQiQ40vZKDE6ftep+FeU3JlpZP1Pqtnf7VSzOfuK8J1qO36ctU76XhRljIrurBc4yYKJq6NFYdfsDu5L95uRlFV4+laDc0VOkuBQxaes2uxob28gx3dbTlRB+2YvKArotC4lcZrf6TShz0Vj3XP+ozWik/3gFZKt2MTQ42LKFIbaHdAx7GtzPlTzYcO2oWWAjGj0OJT8Lx+h7IZKJK+Rk0sZneOQ6+C+doATV9LTMlpOCJePUYvcxvCzWWYZOPOkkxwULsdjbPYfIRezCL52rvxWvpNEPkc4/pLKyAQ1WQ8nK80W/7l9LO2GFqljivMXUf9M/3mvQXoF5dO/1s0eOtvacWu80O3cRPew9dSf4AnFokzu0NadoFledskEa+fs6c/4JkBSWJdfrit/ZJ9KnRXoDiGZvrLDkRHeO5bgY+Xu7uAD1fPLz3d4+xAmaK1rCAO08nM02ke5LHRkpNgdm+CfsazwDlkZhlCS/3jDVaFFYvAczcke/Yy9N9JJP8pp/5+tXeGyqsN6x0sqsKgXDvJMg5gcfzAWdfi+KDYjE+YwV/z80yMksx3n+9xuRmBNUgn4YPiSV/XV4DRn+iBnMsF2OvWqqSueoxEiC/6n0rqh2FOCB5EfuiW72kVawRdqSQQ9nupFCnSwcg0yZNulNo9A9f9VoUWL63y1RwpNxi9zYSncjzEolrN2CjCl2RT05uwUzWOhAhG0de702Crj81W4udS39gq7/6fCKhjVnpgBGQ8ryCTk6sOq1Zv/s5wnjKOL5qmo5g5YjwPZDujgJOFA+0ZLDYxPfWrBfgEFCM5QqFYZJh6+q55Ab85oHyn7HClkfvaB06UBnecJOC10XHgtWSKfbXOD1iLy3yuLIYeyQYmDJg502TakYuw4uX691bb2y9WauxmLI3Kv2t1Mr3bL9QrHWKcFDU+hhKbHMsYn62BkVxXSI5uibIuUwCcqBQR0qrnVSn2riTJKFsCAlePiqtMicepXsdoHycSiXSV5zLpK8jG1VxHTflCCiAPbji6nAnCmVZgIXTK3j3VuoBZnB4OIMOtYXZm1NQy3yWjpYgjFI3q18to9RGsLJD3uLJuRptKsxZpMJkNhJGTxJItpeB6D0A65ADRlpOFtuVZ5Rs12P6CjoIueIk0+qmAZUNbFaUO51slN13mYbGvChi0QZlVNXqwKflX4O1/NgBHMkSVaB8v54iTXQVmLZAPXcDzLgJUzTo6AGkgg5eJiTEicuCtRnQuQW1r5KsMrokARQwXc/K3CTNzBAajhMzTR2tGyZRlJmmdSGRwFWhUg0sogQoSK27qrGy9OikStIBCN0nLFXWUNMGU/MmUZw+AkEkhgroXsfkoEzeqShLSJanwjadox2Sibw4tx8kYqXVJ1e1mmyHUeOrual22pDE+zam4FXWS6QZAhwdlBbr0eJr3u6RPD2FchVX/sZU5sxOkaIUHMcQJaOc8M5Q0gKKFtmYs3U0ERMGHd6hnXIpnGRMCTcH254sKeO1oHUVJEAAaEUDdp1W3+UscPZ9rQnSx+SveCpVTxlSZNJKotqLW6ZivYX0c8L3dRIBEWIi6nhOYoujXKNQciHQndwo8HywjqxkkWFFPKEhWhDGNPgjOVFvIKhGy/2uyHo+MdtIuRV4eRruYkPKMSTOTcecr1M/nQjXYanpn6oyyICE1eS7hzNkRIR6TzclCUZBgaF33jn2gUNSpMo4+U0OF791pu9/Bqu7CQjmbLPvv09erFEuWX1e7FW2Cnd4mgL3eVP1111YdnnxpQ+FGGtSzbW1hc+HFSOeuK5IVdwXowJqyvXrLXx5viokye0ukiPYMLfbG8QI0XOtPNKRTy9X1R1uLFGVF+eLSP1LqcHilD9x3Y052ICAVh0cgl00vaNXPtYcLRaptGyJ2CV4uuIc9EXO1OdWqBUr8J9GQpHFD/tZVkpVlolbS5IwhYbiFTXwtYSaKmcF0l3lKTYdIiQ6AXLTNVAMNGNkAcKrjnIKoFrxowPCKUyKMPcWbFW/HLbK8qCKvNbWowcK6MkDvRHlhqihqAjzdXnfSZ34xDmbIA3PC8Jit+croDx34TZz0HauaUm1vLFXCsA55csJKSAlkARaNMusLLJtajMgCexYtSPmVJAU0VQ4lTkW6osmdS1oJ+q/MuuTkvYj6ZtpTIFmJVYtlh2/jS8spsgOK3RbYSjwmD0uZLzjvJpsx9rQabyDdsIsc2gsUza6t0U/K5EleVZ6hQpXhTWWuKfAuRI029GxLkPf+q/77/If4qcqO4i9Z5kDUSW8j844aiNOILJKcmXoPiW5VJwrRoDnd85ZyVxS42Kfejwa5tGpeo9FAkMxalJYpL4ImLr5Jk1lzNQFoRiBQIx2IwzQ5GuXDDOid8bapkZlEQ+TNgnLHMBJJnaCAKL/K7asqc5ZhCKXRVlsEaBDyg8vf7flx1c987oQu0dEcvtqrESuXppLl+nvtnbm4ewqjdgXLgtngSi8VBp+B+TrxK5yyDpQ9puYQu3M3UJBnt9TZkGCVrmIfZbLkN4nlxozZ5Ktq0oLucvpzgwm9DqDB5IL8O7PpKZVgpvD09rLbtNjyz3U5mXpuVo8w5taoMJrsDN01WuStoevxxAZt67X2JUYBkobtLlT1kCaEXV7muPeHQ+oCFnlZ6CSl1LiKbOVboNPIfB/48wWptYCPye42pTXoQsTd0XqYywydSsizdM/UgGPE1bTmBLAIJtJzmIUrYe2Pskmgc+qb62r5pbHDK+nuah5GvpuwkJuxQQBcgZaExTHhnnaq1ayx6egn9HQhuGF6mfkkdKWdj8LhCV1Pv0idOoccpCYPJaylhVqpD2rGJrnrmnPYGtS0V3u4hoabT3AixaCkLl+WVFLp4onVpK1VW4CuTiMk6u5KRY1TVtlRnTKFZqsvSCvPNlqqVKTQFG0xWxqDlWTMdGi7W0opkDeoIXQmiUOG6YU64imIl4zaryXV5KeQR8xvhe62MqsD2mKcRsAxOxPtYKt+1SRA4ipT6Gviw5TObcMXOeeA2pe6G/URkIeqkgabk994H4LrRxVBLoI1GJZ5NKEw1ZcbwAoTNu8UWCqRF3vg5rFKe8qC1iCqxRsdOxfw95dlLSBu1dUSUWAWhg1vaoqB9jrHj0OfNmTz3APUKNK/P8MBk7T9Qchmk9m8gjx7soe/l/bv2X4NOLWGLwLF4rMohZ7hu9/aDPIujfBWbksfbQ+Bm5oal3xkEEX4TOuu70YezpqDrW1eV+ZGyBxE3SY0HXPpu6No3NF8hkzHuoeh9gQvxKMbnYsy7aL8PyxlU0hxk3rfC14yPpa0v3tN+mfTkU+EV1SgmfQEErC6CB17Bwcx+DT2jK1LoWrSHA8v7Tvtd89MgC/HkqsI9qMJtImnP5WzLfALzDE0BmjjKTS9R9hKCUKEMZaZmcGTMRp1Rm5K1qyB3LlHeu7VOziwT5HaACGHlSJx9cVA65unzKl8g9hzfrCNkXa8zfvcM5y8Z5geYnqnBhTcYy6RZKRVRH5j0YVGthcz1K8S64e2NWHDvgWfwX7j8VZ0TltLehUyY5T7arLYsnPs+crucdy0pR2hnvr9eLrfKk8ThJ0nytVKmv3hF3w7PbJOGxZ0zPTMf64my9Japw7Ta8t0lpeE0K62VIuK+HIaQywddDKrjge+MPkIZUAa3tDYv+GaN1jn7FufKZbFedm9Z0jLclDbXCHBM2fi2xjKUXvuRz0sV/FWd12jzuEo+1LX1wrV+k3WViQnsDOJuputVlu3n2T4zY3FQ2QXK2mgi6Hms4qdZhT5AthvoNO4myzDoJ8oK0jskA7ojYdugrX2euEKlzcuKXVvXPJ2YNcKnAqUO7S9i5kqWHWXZeeIckwW3EFm5mwKk3DXEqwHn6h02KnqdPdKBhGYdcSmgFuyO3Js3Dv7XOipeaUo56OpMG1RPSHTT4+qaRUScMglJ0gxaaJMquHqa5qwKOkmCpOE8lkHm2uRIEiYavNbATbxccQXFMR4pyaiuYFtCOvXLNd9dbpzW7q2ibaOp0C5636iOLJLsB1uPNa0w1Klu+Xplk3lOzua5iHF4Imotol2BFWgPi05d7G2l7xZ7uves8w/m+ed9f/j/RaRNywt92o19Li+859m/+rL6/lm20eX+LFPvN8MYODLyPHDkkK9e+Lyvj/9GakOHbn7JprLNnc6czjxqp3tNrfry0uf90/EfTm92h4tus/vuZn/WWefym8vPtTGcsSVZj9plypj1dyuSMm1MdRFuBH+ooVxaLI0gtIu2iIb+5yekUBzJf0a2q3pObf5V5n9eZR9YFSgmuDMOJ718fX1/Waom8QeD4zxHvMW70PnPvzY4Nf3ZAJafQGZ4wskf/INHf7Lj2fnnNOCzNINx/f2+BPoD6PuFbZEmpQ9dvcY/t/4oSYEk17ogCeVDX+T0zzX+7R1r94OesmeHepqXpz3pQX2zfrlY+8ve/I4z9xzvjz3pAp571RMDUNfjaXXm3HHRMWd9nsy3SuE9eQ0QKFRanP75giTOb0eC7T/QjGohEbAyAYT0QKIV7/pT//KKxLAs7EgIKpqmt2BnqzYW9Btc/+RNa/QNxJc3UkEnV9fr6ZQYCkiEaWI6OD3sg+sRsWKrzf7DsDuJfJSXAv8DEyjX+mT7z5yE37R8VWOtJekCHd1lcke2N489EoAHIvHveFgwt3LpPyAVBj8Wfhzd4sGRf3dW9pHFYvbIXrsaK5AAhyUYzZ/OAqvEyZix0MhQApv3LREYlKr6np/HA/dPICWK7Uo9JEecovznu1r1TLSX0UZ3Oaqo5LKqE13qt5VpJJYWJGtpndiMFdiFCzydTJn7ztciWbhIMfVfALtJmlIqHRAtojRVeiXCCsuVHd0xjPSUPXgRGzL7baNora/rVZGrieRhGHZ6EfrLKJMA3U7E8eToICBrZe3H6rboV1OPQGn++BVq3d7PyjGdSCBgTeR7xRomp5NMUoFagp2NZO5OzsTog9AO+a5Pdfb6cGoLhthAUvBX95MGrielA04dwH1ayKjoqPgaIWsns99q8uD0aOtUmvSRNgLHc7DICbDXPzTpZOoF+6H1MYQK4a+kMkw9lWqlgSYEtEyZ5at5DuztHIhgTbWN3m/pxLPnFmA3WD8Sjdbn3z5gFxlaVxxl/1A02G57DTvIEIH87xdxjoDdI4vROCm7+LBaSzIF9gHKZ3y2cfuzRD0FBXaQKQCyK7YizB1aw8qgY1sEvoRdgw2+RCa2fJM+gIfBS9si8DesZQlri5AlqZz4FM1M+aYToA0bAfAheB28aLAZ4gAyVo/n83KEp1uJkbz8M2TWUKzrsRQO9gcWDi1G9xh9AuaDzxnpxWp97MD72yKIJMRiKIE/YEffEII4Q6QAADvWEK2h9QvUHQH2uSj9jsHT4DN7Na6xGvXuu3y15BkUGvU++quofGT9IhwDIuKTEz5Jly+x6uFE7k0P/X15olgGxp7rNZZnGVakSTGHAuiaMJJR60JAfjW5mb6thvosvX4/PazX8F627OoSBJV4NdB6ijA4iNPkZFJyTKtQuAG+FGyiiQCNykrgYoIeg5z4ZaEvf8S71ghJicx5NcOd1j4p2WIhTBtdtLAtNOfEeclGpTWGqk16XbQFKzasQKzsSwvYCj7d1GFYakwyJXmME61JLEJg2/S4rhMd0iTgJxabgW/SW43CrEJIxiaIIwBGc8g05oMaR1lx9DQZ+AXCiprGgtLKUVNVk2ZN/ysOPhTA7tngP4RxrEshqAKtdQIgls2/gAmMkSjyJUt+HmIppQd9E4ZsL2rlvSWGy7s0i+1t835S1xi8v4EorOeHflRpMyHiREMswXiQnRV1Ee0NMwRLxeGItIBtUpnzpSK4aEcgg2wk1sDp8f1R0OVP5sIbRcqiElT48CAl09SQ/AFA4Hw386z8wZT/nP/VCjJxupEOnhRO/IaWg+1WnvHQ/gz//lmuwjCTcVACxmX4tPc/BO+3wliFjWUzDybEQUjXwhLBqhldtyO1t52gCLqXdwvkCgZ4hE1y8u8m/fae3AqEkxxzfg1TYAmmsRIvwtRFCoIYdm3UEVxGmousaliN2L3x5/+UkTpbYN6pVU+idXBeB20l9yE58+xEXOCtt8ORgWJ5hxDmfD+U16mSAmOfkAb4A3ZVJvpY/P830XWwoC6RzecAfNY/7NyOQfjuFfJVhKlVN0HqCO0FjEFTQ905Zm/vNIlh5KBAwwN8Rk2FjFwg8XQNefYsFQCWgdpfJXZj3Pftx5DFGJYpR2OddfNJZIXIJBLdLj/TLzGn6uGFj88nmX2wxz+L6CrJTyjeJzrf5IQKS78Ixwj5W9FW0a0DfgeJMYmSPLKjx+RMOd76lSg2EvGDMP4yUltkqFU5riIpY1w2Gv1dvEiVqHNLsrAqofQ41rNyiJxQ9By85TfDmJAruTJPHHQE5aePCLxs0jRthtcK4h7xozp7rILx50w58g4RRTsS9ZBxSGz0xZ32ACN+SpqsEMyQCqVxLxQVNo/bMsABK0nL9AF4wK75md3FAMgtyA7IOYj28JrpNGiFPIG8m3TNfhzHrXsNZxJpRsXg1t3itz6nevWHa6kdpJXBuh9mGGIYmErCsAUqtWsfeN5xrxDyUJJjOyu+02zwVRlbRtU6fEasd3tX4fR7phd6L06pKPTavJ36vmbIWKzvjobeJywH7o54Zg8D3JRbAv4PF9g1Q7OY2sWOWXx/VO5H5BiQpZtyE8w3Vb3KRFTMr8Sn8BjmyWHwb3QY0EsG+FAEkiz0RcEj6/nH64iB4AHs1BBfx3fBhwQjg4q8gEyBLyIFAsSAg5giOulzYpcDBMwNhwTD4QoZVgr2RaqBiXFXC7PjI2OOGg47pBASZ4k3z4nDLo7hAU/gnKuwkwS4CxQkWsiDvSoCumgrUaD4XChDAFygdTrnx0MatThEUEWGSW+3A8hBf6VHaQvAcX2cUFQ8+B+l4aftBw/LgqdwfpUQiq8/UpECbYoMpFFhw/hfH18WW8mQA51fECh3OwCWlGHnihb5kY+Ucq9k0Ky488KRW056nqTf13EfK0MtQ5etJpCMQJnRhpKl9KqpgFQ9YuVKAI0A2A3uBESCJBXHs/9mMho0atKsRas2CibMWICwYsOOAycu3Hjw4sNPgCAhwkSIEiNOgiQp0mTJkffW/4egRJkKVWrUadCkRZsOXXr0gRkwZAQCCgYOAckYiglTZtDMYWDhWLBkxZoNW3bsOXDkxJkLV71apEk3o8K5DAVy1evSWmSIixzXipd++ZpfmbX0/HND97eb781966v9bu5F+A2PtQcXhN3tnQHP6+Ine/telx+yfbx9/f0CGgODg0JCw8MiIt9HxUTHxsdNNCUmJCVffRzpkqEwTMt2+qDFux5vUekzVPQbm5iamVtYWlnb2HYLlGCPwxOIJDKFSqP3tGgQYjBhFhtBOVwev1diMUKRWCKVyTEKlUZnMBtsCJzN4fL4AqFI3G/Yz1lQKpMDCitrG1s7ewdHJ2cQghFU2RRPNabpnbvY2vnpccJgNJl7hhTNsEDyxZEurm7uHp5e3j6+fpKlSJUmXYZMWbLlyJUnP3pbqcht/Z8AqlSrUatOvQZ3NWrSrEWrNu06dOqqVrcevfr0GzBoyLARo8YQjZtwz6Qp02bMmjOPJxBJZAqVBtEZTBabw+XxBUKRWCKVyRVKWKXWaHV6g9FktlhtdofT5faYLVab3eF0ASAEIyj2bEFoumFatuPx+vzSbTRb7U631x8MR4aNjCeIxi3fjBqzIsWiTD3u3+dmzS1ML/3g6812t5fn7uFY3t6p0qlGtU/alKjToVCZcve8RO6HgGoeWf3z1+8/f//9V1RNN0zLdlzPD8IoTtIsB8Vqvdnu9ofj6QwRJrSsata0Xc/FME7zIi/X2/3xfL0/319ySmpaekZmVnZObl5+QWFRcUlpWXlF5YQXJVlRNd0wLdtxPT8IozhJs7woq7ppu34Yp9v98Xy9P7wgSrKiakg3TMt2XM8PwihO0iwvSlzVTdv1wzjNy7rtx3ndz7ys236cF4AIE8p0FyKSrKiabpiW7bieoZGxiamZuYWllbWNrZ09Dk8gksgUKo0OgBCDCbPYCMrh8vgCoUgskcrkGIVKozOYLDaHy+MLhCKxRCqTAworaxtbO3sHRydnEIIRVKlSYxqtTo8TBqPJbCFdXN3cPTyDzLrJbHnivH18wy9KliJVmnQZMmX58WcTIMeRJOfPZ1gnLvUeW7gVUvp/20REsF1GBVXNrgBh0/opEec4NND23qD9Oi72rxA8ETCp12hkOQ0+GqjjnJ5DPizTxLX8JIObTjUjT4veiJtt+DVFCrTLijIZNGoCZezQ5mSwJFpc5SxcLUfrJ2KhVCdj1TkZbBaL6x4b5bupb89EtvBY1cTkdplVNPbbFWuCS2tRsyd63MfbThKMOPZXi6iX6MO1edbYNfdEf/rOLjGBmp7vAuObiLsIQzYavyS8hGthnoswBoGQM42+tau1wVsNKy1FHtKFFuVFPUX15TW/+EOuacyvMwlihKkq2nRJesmkpfkpwmxxfp4MmT0Xz5fkYwtTLc8vgck9l1vyIEK5gh4wTpcayMu3Apg4ANnam4Chl8gSgR3Rk3vB4tWi62TYkBbdI9yanH9M+O3O/thDQQjq4sMrKtHxS7CrRorRPgvp5hDNY350JF2ADy7J9JkIQYs58CsTYfXvjQNEx4cCmJS+g3v6t+1vcR6NwSdGJFXNGgsKYxRPbTY4GcGX8I6obj/KD+7GsoLyoEwQhTeABacpP/ms/dvehRXNtkdqiCGvR/8JPbiuOfpwKqpcBVKFUq0ZSfEnUDHTab1Axtop1zm8SKIeHdesNSniZypoZcjbFFsttec81PpLkerKtHNGzNCZ90VjUSdAQlRliGnfGaI5PQpKywXDxIkWXVLvR6ZRXKobI6HbO3oqJc6XvnDoVywsi6HAbjgJwt5L0dHWfHeT90cUvhqSxD8OlsxmEBShEnoOpEyqonshEPZhPqYVMwAMJwkZFysBiJRGJ0BXoiillDLGGGOMMXaKhUIIcU1BSBkXUhXdKwGkjK+QUkprrbXW+lMyW8IkrwiAlHEh1QrBjeo9lz/qbVWNwTfZXJuBuavLRv66vF/t68v7KyHabGY490/CDvajG/VN8vi2rhmH9TmQgyw+hFG8iSahuwmpYO+e3qI6aNzLex7BQ5q0qg1vFTWdziCwz2QwZuBuSQmz1U+q81PQCZt7wZOQEob5KWzjrPCnY6DwBecUrkKWwbFxzWuA9gn8hu9jUddFdIlu7Ih4B4AwLG9n+szNW5q0+K7GI3mQnIJeaGumTtEk5J0gkQ3rx5n9U+esHP8gcvLZb+GsBYUhkoqs/RcApIwLqbQx5uqd93c8DAy7AlyZOdeiGzjiBo+e3IpM+F5j++PVTyRmimf3l09fHJr822tUd2O7FvkEhnwI3BjvCZz60vXpqrXbg264v71s8tbOPpzMRQNIGRdSacO0bCf3SgFAyriQShumZTu5LQGkjAulDdOyndw2KeNCKm2Ylu3kdgggZVxIpQ3Tsp3cTgGkjAuptGFatpPbBaSMC6m0YVp2TrcAUsaFVNowLdvJ7RFAyriQShumZTu5vQJIGRdSacO0bGe6i4sAUsaFVNowLdvJLQTwrZeDawAAIRhBMZwgKZphufhOAEIwgmI4QVI0w3LxXQCEYATFcJJmWC6+G4AQjKAYTpAUzbBcfA8AIRhBMZwgKZphufheACIohhPA2W0SO93/MpX7/dkKybM/42V6CG6JCSx6wO3JsPHwhIiAc7nipAx5N6fmnIGxYaM4jcLtlhWmQYAwl+5XsUGRDe37Mvm1p1mUpWECuf2R4yR2rcQFV+CPHRFl9pMrLTuizH7hqGevZ29Glf2kQpETJmmzXwnwe4ulCWSfVfRhey/QNV7FANxGJRhCCuRLr4by70OnDHrmyjcjGXojHmNtldtYtbiM/Liyq7XbV+s6LvibXBom8486PsOxDusYa99N81Ra1OoTpB5OZdQAyF7H3/Hf+IlGRW/EbCyn/6Jli0Qe5tJLY2y3e6xrN0uGdORYm+VbJSRGCUlJyeeS/wyyhdgNNSyPBiQa8An5SJCcs01CwedZ9GkHJ93n1O78xyaDVOa7D5JkfdRwRUvH2A1S0k9OLHeztxkiIbl1KT3/KTGclqASzh0U4PUyxp/KyJsEgOGuoaHSfQ9eDwuTxqxZvA4WpIF0B70PAAA="
+
+// DefaultAssetsUrl is the path of the assets when the page is not given the one below the request path
+const DefaultAssetsUrl = "/__ondemand/assets"
+
+// Asset is a static file of the built-in pages, served by the middleware itself
+type Asset struct {
+	ContentType string
+	Content     string
+	// ETag identifies the content of the asset, for the conditional requests
+	ETag string
+}
+
+var assets = map[string]Asset{
+	"favicon.svg":  newAsset("image/svg+xml", faviconAsset),
+	"ondemand.css": newAsset("text/css; charset=utf-8", stylesheetAsset),
+	"loading.js":   newAsset("text/javascript; charset=utf-8", loadingScriptAsset),
+	// The pages only use the semibold weight, the other weights are synthesized by the browsers
+	"open-sans-600.woff2": newAsset("font/woff2", mustDecode(fontAsset)),
+}
+
+func mustDecode(encoded string) string {
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		panic(err)
+	}
+	return string(decoded)
+}
+
+func newAsset(contentType string, content string) Asset {
+	sum := sha256.Sum256([]byte(content))
+	return Asset{
+		ContentType: contentType,
+		Content:     content,
+		ETag:        `"` + hex.EncodeToString(sum[:8]) + `"`,
+	}
+}
+
+// GetAsset returns the asset called name
+func GetAsset(name string) (Asset, bool) {
+	asset, ok := assets[name]
+	return asset, ok
+}
@@ -0,0 +1,32 @@
+package pages
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuiltinPages_Assets(t *testing.T) {
+	pages := map[string]string{
+		"loading": GetLoadingPage(nil, time.Minute, LoadingData{Name: "whoami", AssetsUrl: "/app/__ondemand/assets"}),
+		"error":   GetErrorPage(nil, ErrorData{Name: "whoami", AssetsUrl: "/app/__ondemand/assets"}),
+		"closed":  GetClosedPage(nil, ClosedData{Name: "whoami", AssetsUrl: "/app/__ondemand/assets"}),
+	}
+
+	for name, page := range pages {
+		// The only external link is the project one, in the footer
+		assert.NotContains(t, page, "src=\"http", name)
+		assert.NotContains(t, page, "traefik.io", name)
+		assert.NotContains(t, page, "fonts.", name)
+		assert.Contains(t, page, `href="/app/__ondemand/assets/ondemand.css"`, name)
+		assert.Contains(t, page, `href="/app/__ondemand/assets/favicon.svg"`, name)
+	}
+	assert.Contains(t, pages["loading"], `src="/app/__ondemand/assets/loading.js"`)
+
+	for _, name := range []string{"favicon.svg", "ondemand.css", "loading.js", "open-sans-600.woff2"} {
+		asset, ok := GetAsset(name)
+		assert.True(t, ok, name)
+		assert.NotEmpty(t, asset.Content, name)
+	}
+}
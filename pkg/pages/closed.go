@@ -0,0 +1,80 @@
+package pages
+
+var closedPage = `<!doctype html>
+<html lang="{{ .Locale }}">
+
+<head>
+  <title>Ondemand - Closed</title>
+  <meta charset="utf-8" />
+  <meta name="viewport" content="width=device-width, initial-scale=1" />
+
+  <link rel="icon" type="image/svg+xml" href="{{ .AssetsUrl }}/favicon.svg" />
+  <link rel="stylesheet" href="{{ .AssetsUrl }}/ondemand.css" />
+
+  <style type="text/css">
+    .u-flex-center {
+      overflow-y: auto;
+    }
+    .cluster {
+      max-width: 70%;
+    }
+  </style>
+  {{ with .Theme.Style }}<style type="text/css">{{ . }}</style>{{ end }}
+</head>
+
+<body class="u-flex-center">
+  <div class="cluster">
+    {{ if .Theme.LogoUrl }}<img class="logo" src="{{ .Theme.LogoUrl }}" alt="" />{{ end }}
+    <div>
+      <span class="subtitle">{{ t "stack.name" }}</span>
+      <div class="title">{{ .Name }}</div>
+    </div>
+    <div>
+      <span class="subtitle">{{ t "closed.title" }}</span>
+      <div class="title small">
+        {{ t "closed.message" }}<br/>
+        {{ if .Until }}{{ t "closed.until" .Until }}{{ end }}
+      </div>
+    </div>
+  </div>
+
+  <div class="copyright">
+    <div class="heart"></div>
+  </div>
+
+  <footer class="footer title small">
+    <a href="https://github.com/acouvreur/traefik-ondemand-plugin"
+      target="_blank">acouvreur/traefik-ondemand-plugin</a>
+  </footer>
+</body>
+
+</html>`
+
+// ClosedData is the data of the page served while waking the stack up is forbidden by its schedule
+type ClosedData struct {
+	// Locale is the language of the page, the default locale when empty
+	Locale string
+	// Theme is the theme of the page, the built-in look when zero
+	Theme Theme
+	// AssetsUrl is the path the favicon, stylesheet and scripts of the built-in pages are served at, DefaultAssetsUrl when empty
+	AssetsUrl string
+	Name      string
+	// Until is the humanized time the stack can be woken up again
+	Until string
+}
+
+// GetClosedPage renders the closed page with tpl, the built-in page when tpl is nil
+func GetClosedPage(tpl *Template, data ClosedData) string {
+	if tpl == nil {
+		tpl = builtinClosed
+	}
+	if data.Locale == "" {
+		data.Locale = DefaultLocale
+	}
+	if data.AssetsUrl == "" {
+		data.AssetsUrl = DefaultAssetsUrl
+	}
+
+	data.Theme = tpl.theme
+	return tpl.Render(data.Locale, data)
+}
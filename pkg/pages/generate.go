@@ -0,0 +1,65 @@
+//go:build ignore
+// +build ignore
+
+// generate copies the page templates and the assets of this directory into the Go strings of the package,
+// the plugin can neither read them from the disk nor embed them. Run go generate after changing one of them.
+package main
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var sources = []struct {
+	file   string
+	target string
+	name   string
+	// binary files are written as base64 strings
+	binary bool
+}{
+	{file: "loading.html", target: "loading.go", name: "loadingPage"},
+	{file: "error.html", target: "error.go", name: "errorPage"},
+	{file: "closed.html", target: "closed.go", name: "closedPage"},
+	{file: "favicon.svg", target: "assets.go", name: "faviconAsset"},
+	{file: "ondemand.css", target: "assets.go", name: "stylesheetAsset"},
+	{file: "loading.js", target: "assets.go", name: "loadingScriptAsset"},
+	{file: "open-sans-600.woff2", target: "assets.go", name: "fontAsset", binary: true},
+}
+
+func main() {
+	for _, source := range sources {
+		content, err := ioutil.ReadFile(source.file)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		var literal string
+		if source.binary {
+			literal = strconv.Quote(base64.StdEncoding.EncodeToString(content))
+		} else {
+			if strings.Contains(string(content), "`") {
+				log.Fatalf("%s cannot contain a backquote", source.file)
+			}
+			literal = "`" + strings.TrimSuffix(string(content), "\n") + "`"
+		}
+
+		target, err := ioutil.ReadFile(source.target)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		pattern := regexp.MustCompile("(?s)var " + source.name + " = (`[^`]*`|\"[^\"]*\")")
+		if !pattern.Match(target) {
+			log.Fatalf("%s does not declare %s", source.target, source.name)
+		}
+
+		target = pattern.ReplaceAllLiteral(target, []byte("var "+source.name+" = "+literal))
+		if err := ioutil.WriteFile(source.target, target, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
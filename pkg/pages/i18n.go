@@ -0,0 +1,185 @@
+package pages
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultLocale is the locale of the built-in pages when none of the client languages is supported
+const DefaultLocale = "fr"
+
+// catalog are the messages of the built-in pages by locale, the messages are fmt formats and the layouts time formats
+var catalog = map[string]map[string]string{
+	"en": {
+		"stack.name":        "Your stack",
+		"loading.title":     "Waking up...",
+		"loading.message":   "Your stack is waking up, please wait a few minutes...",
+		"loading.contact":   "If you have been waiting for more than 15 minutes, contact the SRE team (#team_sre).",
+		"loading.progress":  "Progress",
+		"loading.tier":      "Step %d/%d",
+		"loading.waiting":   "waiting (step %d)",
+		"loading.eta":       "ready in about %s",
+		"loading.elapsed":   "Waking up for %s",
+		"loading.stop":      "Automatic shutdown",
+		"loading.stop.info": "Your stack will automatically be stopped after %s of inactivity.",
+		"error.title":       "Error :(",
+		"error.message":     "An error occurred while waking your stack up.",
+		"error.contact":     "Contact the SRE team (#team_sre).",
+		"error.services":    "Failed services",
+		"error.retry":       "Retry",
+		"closed.title":      "Stack closed",
+		"closed.message":    "Your stack cannot be woken up outside of its opening hours.",
+		"closed.until":      "It will be available again from %s.",
+		"layout.time":       "15:04",
+		"layout.datetime":   "Jan 2 15:04",
+		"state.started":     "started",
+		"state.starting":    "starting",
+		"state.stopped":     "stopped",
+		"state.waiting":     "waiting",
+		"state.failed":      "failed",
+		"unit.day":          "%d day",
+		"unit.days":         "%d days",
+		"unit.hour":         "%d hour",
+		"unit.hours":        "%d hours",
+		"unit.minute":       "%d minute",
+		"unit.minutes":      "%d minutes",
+		"unit.second":       "%d second",
+		"unit.seconds":      "%d seconds",
+	},
+	"fr": {
+		"stack.name":        "Nom de votre stack",
+		"loading.title":     "Réveil en cours...",
+		"loading.message":   "Votre stack est en train de se réveiller, veuillez patienter quelques minutes...",
+		"loading.contact":   "En cas d'attente de plus de 15 minutes, contactez l'équipe SRE (#team_sre).",
+		"loading.progress":  "Progression",
+		"loading.tier":      "Étape %d/%d",
+		"loading.waiting":   "en attente (étape %d)",
+		"loading.eta":       "prêt dans environ %s",
+		"loading.elapsed":   "Réveil en cours depuis %s",
+		"loading.stop":      "Arrêt automatique",
+		"loading.stop.info": "Votre stack sera automatiquement arrêtée après %s d'inactivité.",
+		"error.title":       "Erreur :(",
+		"error.message":     "Une erreur a eu lieu pendant le réveil de votre stack.",
+		"error.contact":     "Contactez l'équipe SRE (#team_sre).",
+		"error.services":    "Services en erreur",
+		"error.retry":       "Réessayer",
+		"closed.title":      "Stack fermée",
+		"closed.message":    "Votre stack ne peut pas être réveillée en dehors de ses horaires d'ouverture.",
+		"closed.until":      "Elle sera de nouveau disponible à partir de %s.",
+		"layout.time":       "15:04",
+		"layout.datetime":   "02/01 15:04",
+		"state.started":     "démarré",
+		"state.starting":    "en cours de démarrage",
+		"state.stopped":     "arrêté",
+		"state.waiting":     "en attente",
+		"state.failed":      "en erreur",
+		"unit.day":          "%d jour",
+		"unit.days":         "%d jours",
+		"unit.hour":         "%d heure",
+		"unit.hours":        "%d heures",
+		"unit.minute":       "%d minute",
+		"unit.minutes":      "%d minutes",
+		"unit.second":       "%d seconde",
+		"unit.seconds":      "%d secondes",
+	},
+}
+
+// Locales returns the supported locales
+func Locales() []string {
+	locales := make([]string, 0, len(catalog))
+	for locale := range catalog {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// IsSupportedLocale reports whether the built-in pages are translated in locale
+func IsSupportedLocale(locale string) bool {
+	_, ok := catalog[locale]
+	return ok
+}
+
+// NegotiateLocale returns the supported locale preferred by the Accept-Language header, fallback otherwise.
+// Regional variants such as fr-CA match their language.
+func NegotiateLocale(acceptLanguage string, fallback string) string {
+	best, bestQuality := fallback, 0.0
+
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		fields := strings.Split(part, ";")
+		tag := strings.ToLower(strings.TrimSpace(fields[0]))
+		if len(tag) == 0 {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if q, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					quality = q
+				}
+			}
+		}
+
+		language := strings.SplitN(tag, "-", 2)[0]
+		if !IsSupportedLocale(language) || quality <= bestQuality {
+			continue
+		}
+		best, bestQuality = language, quality
+	}
+
+	return best
+}
+
+// Translate returns the message of key in locale formatted with args, the message of the default locale when it is
+// not translated, and key itself when it is unknown
+func Translate(locale string, key string, args ...interface{}) string {
+	message, ok := catalog[locale][key]
+	if !ok {
+		message, ok = catalog[DefaultLocale][key]
+	}
+	if !ok {
+		return key
+	}
+	if len(args) == 0 {
+		return message
+	}
+	return fmt.Sprintf(message, args...)
+}
+
+// HumanizeDuration humanizes the duration in locale with its non-zero units, e.g. 1 hour 30 minutes,
+// golang's default time.Duration output is badly formatted and unreadable
+func HumanizeDuration(locale string, duration time.Duration) string {
+	units := []struct {
+		singular string
+		plural   string
+		size     time.Duration
+	}{
+		{"unit.day", "unit.days", 24 * time.Hour},
+		{"unit.hour", "unit.hours", time.Hour},
+		{"unit.minute", "unit.minutes", time.Minute},
+		{"unit.second", "unit.seconds", time.Second},
+	}
+
+	var parts []string
+	for _, unit := range units {
+		count := int64(duration / unit.size)
+		duration -= time.Duration(count) * unit.size
+
+		switch {
+		case count == 1:
+			parts = append(parts, Translate(locale, unit.singular, count))
+		case count > 1:
+			parts = append(parts, Translate(locale, unit.plural, count))
+		}
+	}
+
+	if len(parts) == 0 {
+		return Translate(locale, "unit.seconds", 0)
+	}
+	return strings.Join(parts, " ")
+}
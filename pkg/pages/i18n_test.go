@@ -0,0 +1,105 @@
+package pages
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiateLocale(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		acceptLanguage string
+		expected       string
+	}{
+		{desc: "no header", acceptLanguage: "", expected: "fr"},
+		{desc: "exact match", acceptLanguage: "en", expected: "en"},
+		{desc: "regional variant", acceptLanguage: "en-GB,en;q=0.9", expected: "en"},
+		{desc: "quality order", acceptLanguage: "de-DE, en;q=0.5, fr;q=0.8", expected: "fr"},
+		{desc: "first of equal qualities", acceptLanguage: "EN-us, fr", expected: "en"},
+		{desc: "unsupported languages", acceptLanguage: "de, es;q=0.5", expected: "fr"},
+		{desc: "refused language", acceptLanguage: "en;q=0", expected: "fr"},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, NegotiateLocale(test.acceptLanguage, "fr"))
+		})
+	}
+}
+
+func TestHumanizeDuration(t *testing.T) {
+	testCases := []struct {
+		duration time.Duration
+		locale   string
+		expected string
+	}{
+		{duration: 0, locale: "en", expected: "0 seconds"},
+		{duration: time.Second, locale: "en", expected: "1 second"},
+		{duration: 90 * time.Second, locale: "en", expected: "1 minute 30 seconds"},
+		{duration: 2 * time.Hour, locale: "en", expected: "2 hours"},
+		{duration: 26*time.Hour + time.Second, locale: "en", expected: "1 day 2 hours 1 second"},
+		{duration: 45 * time.Second, locale: "fr", expected: "45 secondes"},
+		{duration: time.Hour + time.Minute, locale: "fr", expected: "1 heure 1 minute"},
+		{duration: 48 * time.Hour, locale: "fr", expected: "2 jours"},
+		{duration: time.Minute, locale: "de", expected: "1 minute"},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.locale+" "+test.duration.String(), func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, HumanizeDuration(test.locale, test.duration))
+		})
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	assert.Equal(t, "Step 1/2", Translate("en", "loading.tier", 1, 2))
+	assert.Equal(t, "Étape 1/2", Translate("fr", "loading.tier", 1, 2))
+	// Missing translations fallback to the default locale, then to the key
+	assert.Equal(t, "Progression", Translate("de", "loading.progress"))
+	assert.Equal(t, "unknown.key", Translate("en", "unknown.key"))
+
+	// Every message is translated in every locale
+	for _, locale := range Locales() {
+		for key := range catalog[DefaultLocale] {
+			_, ok := catalog[locale][key]
+			assert.True(t, ok, "%s is not translated in %s", key, locale)
+		}
+	}
+}
+
+func TestGetLoadingPage_Locale(t *testing.T) {
+	data := LoadingData{
+		Locale:   "en",
+		Name:     "whoami",
+		Services: []ServiceData{{Name: "db", State: "starting", EstimatedTimeToReady: 90 * time.Second}},
+		Tier:     1,
+		Tiers:    1,
+	}
+
+	page := GetLoadingPage(nil, time.Minute, data)
+	assert.Contains(t, page, `<html lang="en">`)
+	assert.Contains(t, page, "Your stack is waking up")
+	assert.Contains(t, page, "db : starting, ready in about 1 minute 30 seconds")
+	assert.Contains(t, page, "stopped after 1 minute of inactivity")
+
+	// Custom templates use the same translations
+	custom := filepath.Join(t.TempDir(), "loading.html")
+	require.NoError(t, ioutil.WriteFile(custom, []byte(`{{ t "loading.title" }} {{ humanize 3600000000000 }} {{ .Timeout }}`), 0644))
+
+	tpl, err := NewLoadingTemplate(custom)
+	require.NoError(t, err)
+
+	data.Locale = "fr"
+	assert.Equal(t, "Réveil en cours... 1 heure 1 minute", GetLoadingPage(tpl, time.Minute, data))
+}
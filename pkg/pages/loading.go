@@ -1,54 +1,23 @@
 package pages
 
 import (
-	"bytes"
-	"path"
-
-	"fmt"
-	"html/template"
-	"math"
 	"time"
 )
 
 var loadingPage = `<!doctype html>
-<html lang="en-US">
+<html lang="{{ .Locale }}">
 
 <head>
   <title>Ondemand - Loading</title>
   <meta charset="utf-8" />
   <meta name="viewport" content="width=device-width, initial-scale=1" />
 
-  <meta http-equiv="refresh" content="5" />
+  <noscript><meta http-equiv="refresh" content="{{ seconds .RefreshInterval }}" /></noscript>
 
-  <link rel="shortcut icon" href="https://docs.traefik.io/assets/images/logo-traefik-proxy-logo.svg" />
-  <link rel="preconnect" href="https://fonts.gstatic.com/">
+  <link rel="icon" type="image/svg+xml" href="{{ .AssetsUrl }}/favicon.svg" />
+  <link rel="stylesheet" href="{{ .AssetsUrl }}/ondemand.css" />
 
   <style type="text/css">
-    :root {
-      --color-Rose: #ff99a5;
-      --color-Jaune: #ffcc01;
-      --color-Vert: #00cc99;
-      --color-Raven: #0046da;
-      --color-Raven-shadow: #0046da66;
-      --color-Beige: #f6ecdf;
-      --color-Wayne6: #001440;
-      --translate-size: 7px;
-    }
-    html {
-      background-color: var(--color-Wayne6);
-    }
-    body {
-      height: 100vh;
-      width: 100vw;
-      font-family: 'Work Sans', sans-serif;
-      margin: 0;
-      padding: 0;
-    }
-    .u-flex-center {
-      display: flex;
-      justify-content: center;
-      align-items: center;
-    }
     .container {
       height: 100%;
       width: 100%;
@@ -68,89 +37,6 @@ var loadingPage = `<!doctype html>
       align-items: center;
       justify-content: center;
     }
-    .cluster {
-      width: fit-content;
-      margin: auto;
-      margin-top: 30px;
-      margin-bottom: 20px;
-      border-radius: 24px;
-      padding: 24px 46px 1px;
-      background-color: var(--color-Beige);
-      position: relative;
-      transition: 300ms ease-in-out;
-      min-width: 200px;
-    }
-    .cluster:before {
-      content: '';
-      background-color: var(--color-Vert);
-      border-top-left-radius: 24px;
-      border-bottom-left-radius: 24px;
-      position: absolute;
-      bottom: 0;
-      left: 0;
-      top: 0;
-      width: 20px;
-    }
-    .cluster:after {
-      content: '';
-      background-color: var(--color-Rose);
-      border-top-right-radius: 24px;
-      border-bottom-right-radius: 24px;
-      position: absolute;
-      bottom: 0;
-      right: 0;
-      top: 0;
-      width: 20px;
-    }
-    .cluster:hover {
-      transform: translateY(calc(-1 * var(--translate-size)));
-      box-shadow: 0 15px 0 0 var(--color-Raven);
-    }
-    .title {
-      margin-top: 8px;
-      margin-bottom: 24px;
-      font-weight: 600;
-      font-size: 22px;
-    }
-    .title.small {
-      font-size: 14px;
-    }
-    .subtitle {
-      font-weight: 600;
-      font-size: 18px;
-      position: relative;
-    }
-    .subtitle:after {
-      background-color: var(--color-Jaune);
-      height: 5px;
-      bottom: -3px;
-      content: '';
-      left: 0;
-      position: absolute;
-      right: 0;
-      transform: scaleX(0);
-      transform-origin: 100% 50%;
-      transition: transform 300ms ease-in-out;
-    }
-    .cluster:hover .subtitle:after {
-      transform: scaleX(1);
-      transform-origin: 0 50%;
-    }
-    
-    .footer {
-      position: absolute;
-      bottom: 0px;
-    }
-    .footer>a {
-      text-decoration: none;
-      color: var(--color-Beige);
-      transition: 500ms;
-      opacity: .4;
-    }
-    .footer>a:hover {
-      opacity: 1;
-    }
-
     @keyframes spinner-transform {
       0% { transform: translateX(0px) rotate3d(0); animation-timing-function: ease-in-out }
       19% { transform: rotate3d(0, 1, 0, 180deg); transform-origin: right 0; animation-timing-function: steps(1, start);}
@@ -202,74 +88,8 @@ var loadingPage = `<!doctype html>
                 5s steps(1, end) infinite spinner-radius,
                 5s steps(1, end) infinite spinner-colors;
     }
-
-    .copyright {
-      opacity: .3;
-      position: fixed;
-      bottom: 15px;
-      right: 120px;
-      color: var(--color-Beige);
-      transition-duration: 250ms;
-      transform: scale(0.7);
-    }
-    .copyright:hover {
-      opacity: 1;
-      transform: scale(1);
-    }
-    .copyright:before,
-    .copyright:after {
-      opacity: 0;
-      position: absolute;
-      transition-duration: 250ms;
-      white-space: nowrap;
-    }
-    .copyright:before {
-      content: "Designed with";
-      left: -40px;
-    }
-    .copyright:after {
-      content: "by Staylix";
-      right: -35px;
-    }
-    .copyright:hover:before,
-    .copyright:hover:after {
-      opacity: 1;
-      transform: translateX(0);
-    }
-    .copyright:hover:before {
-      left: -110px;
-    }
-    .copyright:hover:after {
-      right: -78px;
-    }
-    .heart {
-      background-color: var(--color-Rose);
-      display: inline-block;
-      height: 14px;
-      position: relative;
-      top: 0;
-      transform: rotate(-45deg);
-      width: 15px;
-      border-radius: 2px;
-    }
-    .heart:before,
-    .heart:after {
-      content: "";
-      background-color: var(--color-Rose);
-      border-radius: 50%;
-      height: 14px;
-      position: absolute;
-      width: 14px;
-    }
-    .heart:before {
-      top: -6px;
-      left: 0;
-    }
-    .heart:after {
-      left: 6px;
-      top: 0;
-    }
   </style>
+  {{ with .Theme.Style }}<style type="text/css">{{ . }}</style>{{ end }}
 </head>
 
 <body class="u-flex-center">
@@ -277,21 +97,34 @@ var loadingPage = `<!doctype html>
     <div class="col">
       <div class="container">
         <div class="cluster">
+          {{ if .Theme.LogoUrl }}<img class="logo" src="{{ .Theme.LogoUrl }}" alt="" />{{ end }}
           <div>
-            <span class="subtitle">Nom de votre stack</span>
+            <span class="subtitle">{{ t "stack.name" }}</span>
             <div class="title">{{ .Name }}</div>
           </div>
           <div>
-            <span class="subtitle">Réveil en cours...</span>
+            <span class="subtitle">{{ t "loading.title" }}</span>
             <div class="title small">
-              Votre stack est en train de se réveiller, veuillez patienter quelques minutes...<br>
-              En cas d'attente de plus de 15 minutes, contactez l'équipe SRE (#team_sre).
+              {{ t "loading.message" }}<br>
+              {{ if .Theme.Contact }}{{ .Theme.Contact }}{{ else }}{{ t "loading.contact" }}{{ end }}
             </div>
           </div>
+          {{ if .Services }}
           <div>
-            <span class="subtitle">Arrêt automatique</span>
+            <span class="subtitle">{{ t "loading.progress" }}</span>
             <div class="title small">
-              Votre stack sera automatiquement arrêtée après {{ .Timeout }} d'inactivité.
+              {{ if .Elapsed }}{{ t "loading.elapsed" (humanize .Elapsed) }}<br>{{ end }}
+              {{ if gt .Tiers 1 }}{{ t "loading.tier" .Tier .Tiers }}<br>{{ end }}
+              {{ range .Services }}
+              {{ .Name }} : {{ if eq .State "waiting" }}{{ t "loading.waiting" .Tier }}{{ else }}{{ t (printf "state.%s" .State) }}{{ end }}{{ if .Progress }} ({{ .Progress }}){{ end }}{{ if .Eta }}, {{ t "loading.eta" .Eta }}{{ end }}{{ if .Message }} - {{ .Message }}{{ end }}<br>
+              {{ end }}
+            </div>
+          </div>
+          {{ end }}
+          <div>
+            <span class="subtitle">{{ t "loading.stop" }}</span>
+            <div class="title small">
+              {{ t "loading.stop.info" .Timeout }}
             </div>
           </div>
         </div>
@@ -309,67 +142,88 @@ var loadingPage = `<!doctype html>
     <a href="https://github.com/acouvreur/traefik-ondemand-plugin"
       target="_blank">acouvreur/traefik-ondemand-plugin</a>
   </footer>
+  <script src="{{ .AssetsUrl }}/loading.js" data-events-url="{{ .EventsUrl }}" data-refresh-interval="{{ seconds .RefreshInterval }}"></script>
 </body>
 </html>`
 
+// DefaultRefreshInterval is the interval the loading page is reloaded at when the events stream is unavailable
+const DefaultRefreshInterval = 5 * time.Second
+
 type LoadingData struct {
-	Name    string
-	Timeout string
+	// Locale is the language of the page, the default locale when empty
+	Locale string
+	// Theme is the theme of the page, the built-in look when zero
+	Theme Theme
+	// AssetsUrl is the path the favicon, stylesheet and scripts of the built-in pages are served at, DefaultAssetsUrl when empty
+	AssetsUrl string
+	// Name is the name of the middleware, kept for the existing templates
+	Name       string
+	Middleware string
+	// Timeout is the humanized session duration, TimeoutDuration the raw one
+	Timeout         string
+	TimeoutDuration time.Duration
+	Services        []ServiceData
+	// Tier is the startup tier currently starting, from 1 to Tiers
+	Tier      int
+	Tiers     int
+	EventsUrl string
+	// Request is the request which is waiting for the services, RetryUrl is the URL to reload the page with
+	Request  RequestData
+	RetryUrl string
+	// RefreshInterval is the interval the page is reloaded at without the events stream, DefaultRefreshInterval when zero
+	RefreshInterval time.Duration
+	// Elapsed is the time since the first service not started yet began to start
+	Elapsed time.Duration
+	// Remaining is the longest estimated time before a service is ready, zero when unknown
+	Remaining time.Duration
 }
 
-func GetLoadingPage(template_path string, name string, timeout time.Duration) string {
-	var tpl *template.Template
-	var err error
-	if template_path != "" {
-		tpl, err = template.New(path.Base(template_path)).ParseFiles(template_path)
-	} else {
-		tpl, err = template.New("loading").Parse(loadingPage)
-	}
-	if err != nil {
-		return err.Error()
-	}
+// ServiceData is the progression of a single service of the stack
+type ServiceData struct {
+	Name                 string
+	State                string
+	Progress             string
+	Message              string
+	ReadyReplicas        int
+	DesiredReplicas      int
+	EstimatedTimeToReady time.Duration
+	// LastTransitionTime is the time the service entered its state, zero when unknown
+	LastTransitionTime time.Time
+	// Tier is the startup tier of the service, from 1
+	Tier int
+
+	locale string
+}
 
-	b := bytes.Buffer{}
-	err = tpl.Execute(&b, LoadingData{
-		Name:    name,
-		Timeout: humanizeDuration(timeout),
-	})
-	if err != nil {
-		return err.Error()
+// Eta returns the humanized estimated time before the service is ready
+func (s ServiceData) Eta() string {
+	if s.EstimatedTimeToReady <= 0 {
+		return ""
 	}
-
-	return b.String()
+	return HumanizeDuration(s.locale, s.EstimatedTimeToReady)
 }
 
-// humanizeDuration humanizes time.Duration output to a meaningful value,
-// golang's default ``time.Duration`` output is badly formatted and unreadable.
-func humanizeDuration(duration time.Duration) string {
-	if duration.Seconds() < 60.0 {
-		return fmt.Sprintf("%d secondes", int64(duration.Seconds()))
+// GetLoadingPage renders the loading page with tpl, the built-in page when tpl is nil
+func GetLoadingPage(tpl *Template, timeout time.Duration, data LoadingData) string {
+	if tpl == nil {
+		tpl = builtinLoading
+	}
+	if data.Locale == "" {
+		data.Locale = DefaultLocale
 	}
-	if duration.Minutes() < 60.0 {
-		remainingSeconds := math.Mod(duration.Seconds(), 60)
-		if remainingSeconds > 0 {
-			return fmt.Sprintf("%d minutes %d secondes", int64(duration.Minutes()), int64(remainingSeconds))
-		}
-		return fmt.Sprintf("%d minutes", int64(duration.Minutes()))
+	if data.AssetsUrl == "" {
+		data.AssetsUrl = DefaultAssetsUrl
+	}
+	if data.RefreshInterval <= 0 {
+		data.RefreshInterval = DefaultRefreshInterval
 	}
-	if duration.Hours() < 24.0 {
-		remainingMinutes := math.Mod(duration.Minutes(), 60)
-		remainingSeconds := math.Mod(duration.Seconds(), 60)
 
-		if remainingMinutes > 0 {
-			if remainingSeconds > 0 {
-				return fmt.Sprintf("%d heures %d minutes %d secondes", int64(duration.Hours()), int64(remainingMinutes), int64(remainingSeconds))
-			}
-			return fmt.Sprintf("%d heures %d minutes", int64(duration.Hours()), int64(remainingMinutes))
-		}
-		return fmt.Sprintf("%d heures", int64(duration.Hours()))
+	data.Timeout = HumanizeDuration(data.Locale, timeout)
+	data.TimeoutDuration = timeout
+	for index := range data.Services {
+		data.Services[index].locale = data.Locale
 	}
-	remainingHours := math.Mod(duration.Hours(), 24)
-	remainingMinutes := math.Mod(duration.Minutes(), 60)
-	remainingSeconds := math.Mod(duration.Seconds(), 60)
-	return fmt.Sprintf("%d jours %d heures %d minutes %d secondes",
-		int64(duration.Hours()/24), int64(remainingHours),
-		int64(remainingMinutes), int64(remainingSeconds))
+
+	data.Theme = tpl.theme
+	return tpl.Render(data.Locale, data)
 }
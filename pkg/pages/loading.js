@@ -0,0 +1,20 @@
+// Reloads the loading page as soon as the services are ready or failed, from the events stream of the middleware
+(function () {
+  var script = document.currentScript;
+  var reload = function () { window.location.reload(); };
+  var interval = Number(script.getAttribute("data-refresh-interval")) * 1000;
+  if (!window.EventSource) {
+    setTimeout(reload, interval);
+    return;
+  }
+  var source = new EventSource(script.getAttribute("data-events-url"));
+  var done = function () { source.close(); reload(); };
+  source.addEventListener("ready", done);
+  source.addEventListener("failed", done);
+  source.onerror = function () {
+    // The stream is unavailable, fallback to a periodic refresh
+    if (source.readyState === EventSource.CLOSED) {
+      setTimeout(reload, interval);
+    }
+  };
+})();
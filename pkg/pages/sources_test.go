@@ -0,0 +1,43 @@
+package pages
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSources(t *testing.T) {
+	testCases := []struct {
+		file  string
+		value string
+		// binary files are copied as they are, the final newline of the text files is trimmed
+		binary bool
+	}{
+		{file: "loading.html", value: loadingPage},
+		{file: "error.html", value: errorPage},
+		{file: "closed.html", value: closedPage},
+		{file: "favicon.svg", value: faviconAsset},
+		{file: "ondemand.css", value: stylesheetAsset},
+		{file: "loading.js", value: loadingScriptAsset},
+		{file: "open-sans-600.woff2", value: mustDecode(fontAsset), binary: true},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.file, func(t *testing.T) {
+			t.Parallel()
+
+			content, err := ioutil.ReadFile(test.file)
+			require.NoError(t, err)
+
+			expected := string(content)
+			if !test.binary {
+				expected = strings.TrimSuffix(expected, "\n")
+			}
+			assert.True(t, expected == test.value, "run go generate after changing %s", test.file)
+		})
+	}
+}
@@ -0,0 +1,329 @@
+package pages
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"sync"
+	"time"
+)
+
+// Template is a page template parsed once, a custom template file is reloaded when its modification time changes
+type Template struct {
+	name string
+	// path is the custom template file, empty for the built-in and inline templates
+	path string
+	// source names the custom template in the logs
+	source  string
+	builtin *localizedTemplate
+	theme   Theme
+	// sample is rendered to validate the custom templates
+	sample interface{}
+
+	mu      sync.Mutex
+	current *localizedTemplate
+	modTime time.Time
+	// statFailed avoids logging the same missing file on every request
+	statFailed bool
+}
+
+// RequestData is the request a page is served to
+type RequestData struct {
+	Method string
+	Host   string
+	Path   string
+}
+
+var (
+	builtinLoading = mustBuiltin("loading", loadingPage, sampleLoadingData)
+	builtinError   = mustBuiltin("error", errorPage, sampleErrorData)
+	builtinClosed  = mustBuiltin("closed", closedPage, sampleClosedData)
+)
+
+var sampleRequestData = RequestData{Method: "GET", Host: "sample", Path: "/sample"}
+
+var sampleLoadingData = LoadingData{
+	Locale:          DefaultLocale,
+	Name:            "sample",
+	Middleware:      "sample",
+	Timeout:         "1 minute",
+	TimeoutDuration: time.Minute,
+	Services: []ServiceData{{
+		Name:                 "sample",
+		State:                "starting",
+		Progress:             "1/2",
+		Message:              "sample",
+		ReadyReplicas:        1,
+		DesiredReplicas:      2,
+		EstimatedTimeToReady: time.Minute,
+		LastTransitionTime:   time.Unix(0, 0),
+		Tier:                 1,
+	}},
+	Tier:            1,
+	Tiers:           1,
+	EventsUrl:       "/__ondemand/events",
+	AssetsUrl:       DefaultAssetsUrl,
+	Request:         sampleRequestData,
+	RetryUrl:        "/sample",
+	RefreshInterval: DefaultRefreshInterval,
+	Elapsed:         time.Minute,
+	Remaining:       time.Minute,
+}
+
+var sampleErrorData = ErrorData{
+	Locale:     DefaultLocale,
+	Name:       "sample",
+	Middleware: "sample",
+	Status:     500,
+	AssetsUrl:  DefaultAssetsUrl,
+	Title:      "Sample",
+	Type:       "urn:sample",
+	Error:      "sample",
+	Services:   []ServiceErrorData{{Name: "sample", Error: "sample"}},
+	Request:    sampleRequestData,
+	RetryUrl:   "/sample",
+}
+
+var sampleClosedData = ClosedData{
+	Locale:    DefaultLocale,
+	AssetsUrl: DefaultAssetsUrl,
+	Name:      "sample",
+	Until:     "08:00",
+}
+
+// localizedTemplate is a parsed template with a copy for each locale, executed with the template functions of the
+// locale. html/template escapes a copy on its first execution, the copies are kept so that it happens only once.
+type localizedTemplate struct {
+	tpl *template.Template
+
+	mu     sync.Mutex
+	copies map[string]*template.Template
+}
+
+func newLocalizedTemplate(tpl *template.Template) *localizedTemplate {
+	return &localizedTemplate{tpl: tpl, copies: make(map[string]*template.Template)}
+}
+
+func mustBuiltin(name string, text string, sample interface{}) *Template {
+	tpl := newLocalizedTemplate(template.Must(template.New(name).Funcs(Funcs(DefaultLocale)).Parse(text)))
+	return &Template{name: name, builtin: tpl, current: tpl, sample: sample}
+}
+
+// NewLoadingTemplate parses the loading page template at path, the built-in page is used when path is empty
+func NewLoadingTemplate(path string) (*Template, error) {
+	return builtinLoading.withFile(path)
+}
+
+// NewErrorTemplate parses the error page template at path, the built-in page is used when path is empty
+func NewErrorTemplate(path string) (*Template, error) {
+	return builtinError.withFile(path)
+}
+
+// NewClosedTemplate parses the closed page template at path, the built-in page is used when path is empty
+func NewClosedTemplate(path string) (*Template, error) {
+	return builtinClosed.withFile(path)
+}
+
+// ParseLoadingTemplate parses an inline loading page template
+func ParseLoadingTemplate(text string) (*Template, error) {
+	return builtinLoading.withText(text)
+}
+
+// ParseErrorTemplate parses an inline error page template
+func ParseErrorTemplate(text string) (*Template, error) {
+	return builtinError.withText(text)
+}
+
+// ParseClosedTemplate parses an inline closed page template
+func ParseClosedTemplate(text string) (*Template, error) {
+	return builtinClosed.withText(text)
+}
+
+// WithTheme returns a copy of the template rendering the pages with theme, it must be called before the template is used
+func (t *Template) WithTheme(theme Theme) *Template {
+	return &Template{
+		name:    t.name,
+		path:    t.path,
+		source:  t.source,
+		builtin: t.builtin,
+		theme:   theme,
+		sample:  t.sample,
+		current: t.current,
+		modTime: t.modTime,
+	}
+}
+
+// withFile returns a template read from path, t itself when path is empty
+func (t *Template) withFile(path string) (*Template, error) {
+	if len(path) == 0 {
+		return t, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	tpl, err := parseFile(path, t.sample)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Template{
+		name:    t.name,
+		path:    path,
+		source:  path,
+		builtin: t.builtin,
+		theme:   t.theme,
+		sample:  t.sample,
+		current: tpl,
+		modTime: info.ModTime(),
+	}, nil
+}
+
+// withText returns a template parsed from text, which is never reloaded
+func (t *Template) withText(text string) (*Template, error) {
+	tpl, err := parse("inline "+t.name, text, t.sample)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Template{
+		name:    t.name,
+		source:  "the inline " + t.name + " template",
+		builtin: t.builtin,
+		theme:   t.theme,
+		sample:  t.sample,
+		current: tpl,
+	}, nil
+}
+
+// parseFile parses the template at path and validates it by rendering sample
+func parseFile(file string, sample interface{}) (*localizedTemplate, error) {
+	text, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return parse(path.Base(file), string(text), sample)
+}
+
+// parse parses text and validates it by rendering sample
+func parse(name string, text string, sample interface{}) (*localizedTemplate, error) {
+	parsed, err := template.New(name).Funcs(Funcs(DefaultLocale)).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	tpl := newLocalizedTemplate(parsed)
+	if _, err := tpl.render(DefaultLocale, sample); err != nil {
+		return nil, err
+	}
+
+	return tpl, nil
+}
+
+// Render renders the template with data in locale. The built-in page is rendered when a custom template fails.
+func (t *Template) Render(locale string, data interface{}) string {
+	tpl := t.load()
+
+	page, err := tpl.render(locale, data)
+	if err != nil && tpl != t.builtin {
+		log.Printf("Could not render %s, rendering the built-in %s page: %v", t.source, t.name, err)
+		page, err = t.builtin.render(locale, data)
+	}
+
+	if err != nil {
+		log.Printf("Could not render the built-in %s page: %v", t.name, err)
+		return ""
+	}
+	return page
+}
+
+// load returns the current template, reloading the file first when its modification time changed.
+// The last good template is kept when the file cannot be reloaded.
+func (t *Template) load() *localizedTemplate {
+	if len(t.path) == 0 {
+		return t.current
+	}
+
+	info, statErr := os.Stat(t.path)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if statErr != nil {
+		if !t.statFailed {
+			log.Printf("Could not reload %s, keeping the last good template: %v", t.path, statErr)
+		}
+		t.statFailed = true
+		return t.current
+	}
+	t.statFailed = false
+
+	if info.ModTime().Equal(t.modTime) {
+		return t.current
+	}
+	t.modTime = info.ModTime()
+
+	tpl, err := parseFile(t.path, t.sample)
+	if err != nil {
+		log.Printf("Could not reload %s, keeping the last good template: %v", t.path, err)
+		return t.current
+	}
+
+	log.Printf("Reloaded %s", t.path)
+	t.current = tpl
+	return t.current
+}
+
+// Funcs returns the template functions of locale, available to the custom templates:
+// t translates a message, humanize humanizes a duration, seconds converts a duration to whole seconds
+// and json encodes a value, for the scripts of the page
+func Funcs(locale string) template.FuncMap {
+	return template.FuncMap{
+		"t": func(key string, args ...interface{}) string {
+			return Translate(locale, key, args...)
+		},
+		"humanize": func(duration time.Duration) string {
+			return HumanizeDuration(locale, duration)
+		},
+		"seconds": func(duration time.Duration) int64 {
+			return int64(duration / time.Second)
+		},
+		"json": func(value interface{}) (template.JS, error) {
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return "", err
+			}
+			return template.JS(encoded), nil
+		},
+	}
+}
+
+// render executes the copy of the template for locale, copying it on the first use of the locale
+func (l *localizedTemplate) render(locale string, data interface{}) (string, error) {
+	l.mu.Lock()
+	localized, ok := l.copies[locale]
+	if !ok {
+		clone, err := l.tpl.Clone()
+		if err != nil {
+			l.mu.Unlock()
+			return "", err
+		}
+		localized = clone.Funcs(Funcs(locale))
+		l.copies[locale] = localized
+	}
+	l.mu.Unlock()
+
+	b := bytes.Buffer{}
+	if err := localized.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("%s: %w", l.tpl.Name(), err)
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,157 @@
+package pages
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLoadingTemplate(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		content     string
+		expectedErr bool
+	}{
+		{desc: "valid template", content: `{{ .Name }} {{ t "loading.title" }}`},
+		{desc: "parse error", content: `{{ .Name `, expectedErr: true},
+		{desc: "unknown field", content: `{{ .Nmae }}`, expectedErr: true},
+		{desc: "unknown function", content: `{{ translate "loading.title" }}`, expectedErr: true},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			file := filepath.Join(t.TempDir(), "loading.html")
+			require.NoError(t, ioutil.WriteFile(file, []byte(test.content), 0644))
+
+			_, err := NewLoadingTemplate(file)
+
+			if test.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	_, err := NewLoadingTemplate(filepath.Join(t.TempDir(), "missing.html"))
+	assert.Error(t, err)
+
+	tpl, err := NewLoadingTemplate("")
+	require.NoError(t, err)
+	assert.Equal(t, builtinLoading, tpl)
+}
+
+func TestTemplate_Reload(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "error.html")
+	modTime := time.Now().Add(-time.Hour)
+	write := func(content string) {
+		require.NoError(t, ioutil.WriteFile(file, []byte(content), 0644))
+		modTime = modTime.Add(time.Minute)
+		require.NoError(t, os.Chtimes(file, modTime, modTime))
+	}
+
+	write(`first {{ .Name }}`)
+	tpl, err := NewErrorTemplate(file)
+	require.NoError(t, err)
+
+	data := ErrorData{Name: "whoami"}
+	assert.Equal(t, "first whoami", GetErrorPage(tpl, data))
+
+	write(`second {{ .Name }}`)
+	assert.Equal(t, "second whoami", GetErrorPage(tpl, data))
+
+	// The last good template is kept when the file is invalid or missing
+	write(`third {{ .Name `)
+	assert.Equal(t, "second whoami", GetErrorPage(tpl, data))
+
+	require.NoError(t, os.Remove(file))
+	assert.Equal(t, "second whoami", GetErrorPage(tpl, data))
+
+	write(`fourth {{ .Name }}`)
+	assert.Equal(t, "fourth whoami", GetErrorPage(tpl, data))
+}
+
+func TestTemplate_Render_Locales(t *testing.T) {
+	tpl, err := ParseLoadingTemplate(`{{ t "loading.title" }}`)
+	require.NoError(t, err)
+
+	assert.Equal(t, Translate("fr", "loading.title"), tpl.Render("fr", sampleLoadingData))
+	assert.Equal(t, Translate("en", "loading.title"), tpl.Render("en", sampleLoadingData))
+
+	// The copy of each locale is escaped once and reused by the following pages
+	fr := tpl.current.copies["fr"]
+	assert.Equal(t, Translate("fr", "loading.title"), tpl.Render("fr", sampleLoadingData))
+	assert.True(t, fr == tpl.current.copies["fr"])
+	assert.Len(t, tpl.current.copies, 2)
+}
+
+func TestTemplate_Render_Fallback(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "error.html")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`{{ (index .Services 0).Name }}`), 0644))
+
+	tpl, err := NewErrorTemplate(file)
+	require.NoError(t, err)
+
+	assert.Equal(t, "db", GetErrorPage(tpl, ErrorData{Services: []ServiceErrorData{{Name: "db"}}}))
+
+	// The built-in page is rendered when the custom template fails with the data
+	page := GetErrorPage(tpl, ErrorData{Name: "whoami"})
+	assert.Contains(t, page, "<!doctype html>")
+	assert.Contains(t, page, "whoami")
+}
+
+func TestFuncs(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		content  string
+		expected string
+	}{
+		{desc: "humanize", content: `{{ humanize .TimeoutDuration }}`, expected: "1 minute"},
+		{desc: "seconds", content: `{{ seconds .RefreshInterval }}`, expected: "5"},
+		{desc: "json in a script", content: `<script>var request = {{ json .Request }};</script>`, expected: `<script>var request = {"Method":"GET","Host":"mydomain","Path":"/whoami"};</script>`},
+		{desc: "json in text", content: `{{ json .Request.Path }}`, expected: "&#34;/whoami&#34;"},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			file := filepath.Join(t.TempDir(), "loading.html")
+			require.NoError(t, ioutil.WriteFile(file, []byte(test.content), 0644))
+
+			tpl, err := NewLoadingTemplate(file)
+			require.NoError(t, err)
+
+			page := GetLoadingPage(tpl, time.Minute, LoadingData{
+				Locale:  "en",
+				Request: RequestData{Method: "GET", Host: "mydomain", Path: "/whoami"},
+			})
+
+			assert.Equal(t, test.expected, page)
+		})
+	}
+}
+
+func TestParseLoadingTemplate(t *testing.T) {
+	_, err := ParseLoadingTemplate(`{{ .Nmae }}`)
+	assert.Error(t, err)
+
+	tpl, err := ParseLoadingTemplate(`{{ .Name }} {{ .Theme.Name }} {{ .Theme.Contact }}`)
+	require.NoError(t, err)
+
+	theme, err := NewTheme("dark")
+	require.NoError(t, err)
+	theme.Contact = "#platform"
+
+	assert.Equal(t, "whoami  ", GetLoadingPage(tpl, time.Minute, LoadingData{Name: "whoami"}))
+	assert.Equal(t, "whoami dark #platform", GetLoadingPage(tpl.WithTheme(theme), time.Minute, LoadingData{Name: "whoami"}))
+}
@@ -0,0 +1,134 @@
+package pages
+
+import (
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+)
+
+// DefaultTheme is the theme of the built-in pages when none is configured
+const DefaultTheme = "default"
+
+// Theme customizes the look of the built-in pages, the custom templates receive it as .Theme
+type Theme struct {
+	// Name is the preset the theme is based on
+	Name string
+	// LogoUrl is the image displayed at the top of the pages, no logo is displayed when empty
+	LogoUrl string
+	// Contact replaces the contact message of the pages when set
+	Contact string
+	Colors  ThemeColors
+
+	// rules are the additional style rules of the preset
+	rules string
+}
+
+// ThemeColors are the colors of a theme, as #rgb or #rrggbb hexadecimal colors
+type ThemeColors struct {
+	// Background is the color of the page behind the card
+	Background string
+	// Surface is the color of the card
+	Surface string
+	Text    string
+	// Primary is the color of the shadows and of the spinner
+	Primary string
+	// Accent is the color of the underlines
+	Accent string
+}
+
+var themes = map[string]Theme{
+	DefaultTheme: {
+		Colors: ThemeColors{Background: "#001440", Surface: "#f6ecdf", Text: "#000000", Primary: "#0046da", Accent: "#ffcc01"},
+	},
+	"minimal": {
+		Colors: ThemeColors{Background: "#ffffff", Surface: "#ffffff", Text: "#222222", Primary: "#555555", Accent: "#dddddd"},
+		rules: `.cluster:before, .cluster:after, .copyright, .footer { display: none; }
+.cluster { border: 1px solid var(--color-Accent); }
+.cluster:hover { transform: none; box-shadow: none; }`,
+	},
+	"dark": {
+		Colors: ThemeColors{Background: "#0d1117", Surface: "#161b22", Text: "#e6edf3", Primary: "#58a6ff", Accent: "#3fb950"},
+		rules:  `.footer>a { color: var(--color-Text); }`,
+	},
+	"corporate": {
+		Colors: ThemeColors{Background: "#f4f5f7", Surface: "#ffffff", Text: "#172b4d", Primary: "#0052cc", Accent: "#0052cc"},
+		rules: `.cluster:before, .cluster:after, .copyright { display: none; }
+.cluster { border-radius: 8px; box-shadow: 0 1px 3px rgba(0, 0, 0, 0.2); }
+.cluster:hover { transform: none; box-shadow: 0 1px 3px rgba(0, 0, 0, 0.2); }
+.footer>a { color: var(--color-Text); }`,
+	},
+}
+
+// Themes returns the names of the theme presets
+func Themes() []string {
+	names := make([]string, 0, len(themes))
+	for name := range themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewTheme returns the theme preset called name
+func NewTheme(name string) (Theme, error) {
+	theme, ok := themes[name]
+	if !ok {
+		return Theme{}, fmt.Errorf("unknown theme %s, must be one of %s", name, strings.Join(Themes(), ", "))
+	}
+	theme.Name = name
+	return theme, nil
+}
+
+// ParseColor validates a #rgb or #rrggbb hexadecimal color and returns it in the #rrggbb form
+func ParseColor(color string) (string, error) {
+	if !strings.HasPrefix(color, "#") || (len(color) != 4 && len(color) != 7) {
+		return "", fmt.Errorf("%s is not a #rgb or #rrggbb color", color)
+	}
+
+	for _, c := range color[1:] {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return "", fmt.Errorf("%s is not a #rgb or #rrggbb color", color)
+		}
+	}
+
+	color = strings.ToLower(color)
+	if len(color) == 4 {
+		return string([]byte{'#', color[1], color[1], color[2], color[2], color[3], color[3]}), nil
+	}
+	return color, nil
+}
+
+// Style returns the style rules applying the theme over the built-in style, empty for the zero theme.
+// The colors are written as they are, they must have been validated with ParseColor.
+func (t Theme) Style() template.CSS {
+	if t.Name == "" {
+		return ""
+	}
+
+	colors := t.Colors
+	style := strings.Builder{}
+	style.WriteString(":root {\n")
+	for _, variable := range []struct{ name, value string }{
+		{"--color-Wayne6", colors.Background},
+		{"--color-Beige", colors.Surface},
+		{"--color-Text", colors.Text},
+		{"--color-Raven", colors.Primary},
+		{"--color-Raven-shadow", colors.Primary + "66"},
+		{"--color-Jaune", colors.Accent},
+		{"--color-Accent", colors.Accent},
+	} {
+		fmt.Fprintf(&style, "  %s: %s;\n", variable.name, variable.value)
+	}
+	style.WriteString("}\n.cluster { color: var(--color-Text); }\n")
+
+	if len(t.LogoUrl) != 0 {
+		style.WriteString(".logo { display: block; max-width: 200px; max-height: 64px; margin: 0 auto 16px; }\n")
+	}
+	if len(t.rules) != 0 {
+		style.WriteString(t.rules)
+		style.WriteString("\n")
+	}
+
+	return template.CSS(style.String())
+}
@@ -0,0 +1,61 @@
+package pages
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseColor(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		color       string
+		expected    string
+		expectedErr bool
+	}{
+		{desc: "short color", color: "#C0f", expected: "#cc00ff"},
+		{desc: "long color", color: "#0046DA", expected: "#0046da"},
+		{desc: "named color", color: "red", expectedErr: true},
+		{desc: "missing digits", color: "#0046d", expectedErr: true},
+		{desc: "not hexadecimal", color: "#00z", expectedErr: true},
+		{desc: "style injection", color: "#000;}", expectedErr: true},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			color, err := ParseColor(test.color)
+
+			if test.expectedErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.expected, color)
+			}
+		})
+	}
+}
+
+func TestTheme_Style(t *testing.T) {
+	assert.Empty(t, Theme{}.Style())
+
+	_, err := NewTheme("neon")
+	assert.Error(t, err)
+
+	for _, name := range Themes() {
+		theme, err := NewTheme(name)
+		require.NoError(t, err)
+		assert.Contains(t, string(theme.Style()), "--color-Raven-shadow: "+theme.Colors.Primary+"66;")
+	}
+
+	minimal, err := NewTheme("minimal")
+	require.NoError(t, err)
+	assert.Contains(t, string(minimal.Style()), ".copyright, .footer { display: none; }")
+	assert.NotContains(t, string(minimal.Style()), ".logo")
+
+	minimal.LogoUrl = "https://example.com/logo.svg"
+	assert.Contains(t, string(minimal.Style()), ".logo")
+}
@@ -0,0 +1,224 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Window is a weekly time range starting on some days of the week, in the location of the schedule
+type Window struct {
+	// Days are the days the window starts on, indexed by time.Weekday
+	Days [7]bool
+	// Start and End are minutes since midnight of the start day, End is after the next midnight
+	// when the window spans two days
+	Start int
+	End   int
+}
+
+// ParseWindow parses a window from days in cron-like format, e.g. mon-fri or sat,sun or *,
+// and the from and to clock times, e.g. 08:00 and 19:00. A to earlier than from ends the next day.
+func ParseWindow(days string, from string, to string) (Window, error) {
+	var window Window
+
+	if err := parseDays(days, &window.Days); err != nil {
+		return Window{}, err
+	}
+
+	start, err := parseClock(from)
+	if err != nil {
+		return Window{}, fmt.Errorf("invalid from: %w", err)
+	}
+
+	end, err := parseClock(to)
+	if err != nil {
+		return Window{}, fmt.Errorf("invalid to: %w", err)
+	}
+
+	if start == 24*60 {
+		return Window{}, fmt.Errorf("invalid from: 24:00 can only end a window")
+	}
+
+	if end <= start {
+		end += 24 * 60
+	}
+
+	window.Start = start
+	window.End = end
+	return window, nil
+}
+
+func parseDays(days string, selected *[7]bool) error {
+	days = strings.TrimSpace(strings.ToLower(days))
+	if len(days) == 0 {
+		return fmt.Errorf("days cannot be empty")
+	}
+
+	for _, field := range strings.Split(days, ",") {
+		field = strings.TrimSpace(field)
+		if field == "*" {
+			for day := range selected {
+				selected[day] = true
+			}
+			continue
+		}
+
+		bounds := strings.SplitN(field, "-", 2)
+		first, err := parseDay(bounds[0])
+		if err != nil {
+			return err
+		}
+
+		last := first
+		if len(bounds) == 2 {
+			if last, err = parseDay(bounds[1]); err != nil {
+				return err
+			}
+		}
+
+		// Ranges wrap around the end of the week, e.g. fri-mon
+		for day := first; ; day = (day + 1) % 7 {
+			selected[day] = true
+			if day == last {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseDay parses a day name, or a cron day number where both 0 and 7 are Sunday
+func parseDay(value string) (time.Weekday, error) {
+	value = strings.TrimSpace(value)
+	if day, ok := weekdays[value]; ok {
+		return day, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 0 || number > 7 {
+		return 0, fmt.Errorf("invalid day %q, must be one of sun, mon, tue, wed, thu, fri, sat or 0-7", value)
+	}
+	return time.Weekday(number % 7), nil
+}
+
+// parseClock parses a HH:MM clock time into minutes since midnight, 24:00 being the end of the day
+func parseClock(value string) (int, error) {
+	parts := strings.Split(strings.TrimSpace(value), ":")
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) != 2 {
+		return 0, fmt.Errorf("%q must be formatted as HH:MM", value)
+	}
+
+	hours, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, fmt.Errorf("%q must be formatted as HH:MM", value)
+	}
+
+	minutes, err := strconv.Atoi(parts[1])
+	if err != nil || minutes < 0 || minutes > 59 {
+		return 0, fmt.Errorf("%q must be formatted as HH:MM", value)
+	}
+
+	if hours < 0 || hours > 24 || (hours == 24 && minutes != 0) {
+		return 0, fmt.Errorf("%q is not a time of day", value)
+	}
+
+	return hours*60 + minutes, nil
+}
+
+// occurrence returns the occurrence of the window containing t, if any.
+// The clock times are kept across daylight saving time changes.
+func (w Window) occurrence(t time.Time) (time.Time, time.Time, bool) {
+	// The occurrence containing t started either the same day or the day before
+	for back := 0; back <= 1; back++ {
+		year, month, day := t.AddDate(0, 0, -back).Date()
+		midnight := time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+
+		if !w.Days[midnight.Weekday()] {
+			continue
+		}
+
+		start := time.Date(year, month, day, 0, w.Start, 0, 0, t.Location())
+		end := time.Date(year, month, day, 0, w.End, 0, 0, t.Location())
+
+		if !t.Before(start) && t.Before(end) {
+			return start, end, true
+		}
+	}
+	return time.Time{}, time.Time{}, false
+}
+
+// Schedule are the windows where the group is kept awake, and the windows where it cannot be woken up
+type Schedule struct {
+	Location *time.Location
+	Awake    []Window
+	Closed   []Window
+}
+
+// IsZero reports whether the schedule has no window
+func (s Schedule) IsZero() bool {
+	return len(s.Awake) == 0 && len(s.Closed) == 0
+}
+
+// IsAwake reports whether t is in an awake window, closed windows take precedence
+func (s Schedule) IsAwake(t time.Time) bool {
+	if _, closed := s.ClosedUntil(t); closed {
+		return false
+	}
+	_, ok := find(s.Awake, s.in(t))
+	return ok
+}
+
+// ClosedUntil returns the end of the closed windows containing t, false when t is not in a closed window.
+// Windows following each other are merged.
+func (s Schedule) ClosedUntil(t time.Time) (time.Time, bool) {
+	t = s.in(t)
+
+	until, ok := find(s.Closed, t)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	// A week of adjacent windows is closed forever, stop there
+	for limit := t.AddDate(0, 0, 7); until.Before(limit); {
+		next, ok := find(s.Closed, until)
+		if !ok {
+			break
+		}
+		until = next
+	}
+	return until, true
+}
+
+func (s Schedule) in(t time.Time) time.Time {
+	if s.Location == nil {
+		return t
+	}
+	return t.In(s.Location)
+}
+
+// find returns the latest end of the windows containing t
+func find(windows []Window, t time.Time) (time.Time, bool) {
+	var until time.Time
+	found := false
+	for _, window := range windows {
+		if _, end, ok := window.occurrence(t); ok {
+			if !found || end.After(until) {
+				until = end
+			}
+			found = true
+		}
+	}
+	return until, found
+}
@@ -0,0 +1,153 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWindow(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		days         string
+		from         string
+		to           string
+		expectedDays []time.Weekday
+		expected     Window
+		expectedErr  bool
+	}{
+		{
+			desc:         "business days",
+			days:         "mon-fri",
+			from:         "08:00",
+			to:           "19:30",
+			expectedDays: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+			expected:     Window{Start: 8 * 60, End: 19*60 + 30},
+		},
+		{
+			desc:         "list and cron numbers",
+			days:         "Sat, 0",
+			from:         "00:00",
+			to:           "24:00",
+			expectedDays: []time.Weekday{time.Sunday, time.Saturday},
+			expected:     Window{Start: 0, End: 24 * 60},
+		},
+		{
+			desc:         "range wrapping around the week",
+			days:         "fri-mon",
+			from:         "20:00",
+			to:           "08:00",
+			expectedDays: []time.Weekday{time.Sunday, time.Monday, time.Friday, time.Saturday},
+			expected:     Window{Start: 20 * 60, End: 32 * 60},
+		},
+		{
+			desc:         "every day",
+			days:         "*",
+			from:         "9:00",
+			to:           "9:00",
+			expectedDays: []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday},
+			expected:     Window{Start: 9 * 60, End: 33 * 60},
+		},
+		{desc: "empty days", days: "", from: "08:00", to: "19:00", expectedErr: true},
+		{desc: "unknown day", days: "monday", from: "08:00", to: "19:00", expectedErr: true},
+		{desc: "invalid day number", days: "8", from: "08:00", to: "19:00", expectedErr: true},
+		{desc: "invalid from", days: "*", from: "8h", to: "19:00", expectedErr: true},
+		{desc: "invalid minutes", days: "*", from: "08:60", to: "19:00", expectedErr: true},
+		{desc: "invalid to", days: "*", from: "08:00", to: "25:00", expectedErr: true},
+		{desc: "from end of day", days: "*", from: "24:00", to: "08:00", expectedErr: true},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			window, err := ParseWindow(test.days, test.from, test.to)
+
+			if test.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			for _, day := range test.expectedDays {
+				test.expected.Days[day] = true
+			}
+			assert.Equal(t, test.expected, window)
+		})
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	window := func(days string, from string, to string) Window {
+		window, err := ParseWindow(days, from, to)
+		require.NoError(t, err)
+		return window
+	}
+
+	schedule := Schedule{
+		Location: paris,
+		Awake:    []Window{window("mon-fri", "08:00", "19:00")},
+		Closed:   []Window{window("mon-thu", "22:00", "06:00"), window("fri", "22:00", "24:00"), window("sat,sun", "00:00", "24:00"), window("mon", "00:00", "06:00")},
+	}
+
+	testCases := []struct {
+		desc          string
+		time          time.Time
+		expectedAwake bool
+		expectedUntil time.Time
+	}{
+		{
+			desc:          "business hours",
+			time:          time.Date(2021, 6, 1, 10, 0, 0, 0, paris),
+			expectedAwake: true,
+		},
+		{
+			desc: "evening",
+			time: time.Date(2021, 6, 1, 20, 0, 0, 0, paris),
+		},
+		{
+			desc:          "night",
+			time:          time.Date(2021, 6, 1, 23, 0, 0, 0, paris),
+			expectedUntil: time.Date(2021, 6, 2, 6, 0, 0, 0, paris),
+		},
+		{
+			desc:          "night after midnight",
+			time:          time.Date(2021, 6, 2, 5, 59, 0, 0, paris),
+			expectedUntil: time.Date(2021, 6, 2, 6, 0, 0, 0, paris),
+		},
+		{
+			desc:          "weekend windows are merged",
+			time:          time.Date(2021, 6, 4, 23, 0, 0, 0, paris),
+			expectedUntil: time.Date(2021, 6, 7, 6, 0, 0, 0, paris),
+		},
+		{
+			desc:          "time in another location",
+			time:          time.Date(2021, 6, 1, 7, 0, 0, 0, time.UTC),
+			expectedAwake: true,
+		},
+		{
+			desc:          "daylight saving time change",
+			time:          time.Date(2021, 3, 27, 23, 0, 0, 0, paris),
+			expectedUntil: time.Date(2021, 3, 29, 6, 0, 0, 0, paris),
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expectedAwake, schedule.IsAwake(test.time))
+
+			until, closed := schedule.ClosedUntil(test.time)
+			assert.Equal(t, !test.expectedUntil.IsZero(), closed)
+			assert.True(t, test.expectedUntil.Equal(until), "closed until %s, expected %s", until, test.expectedUntil)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package strategy
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// ActivityBatcher records the activity of the services locally and reports it to the ondemand service in the background,
+// at most once per Interval for each service, so that the requests forwarded to started services never wait for it
+type ActivityBatcher struct {
+	Interval time.Duration
+
+	mu       sync.Mutex
+	services map[string]*activityEntry
+
+	// flush, now and schedule are replaced in tests
+	flush    func(ctx context.Context, service Service) error
+	now      func() time.Time
+	schedule func(delay time.Duration, f func())
+}
+
+type activityEntry struct {
+	// pending reports activity recorded since the last flush started
+	pending bool
+	// scheduled reports a flush scheduled or in progress
+	scheduled bool
+	flushedAt time.Time
+	// reportedAt is the start of the last successful flush
+	reportedAt time.Time
+}
+
+// NewActivityBatcher creates a batcher reporting the activity with the activity operation of the services,
+// or by refreshing their status for the services without one, the status call recording their activity
+func NewActivityBatcher(interval time.Duration, actions *Actions, cache *StatusCache) *ActivityBatcher {
+	return &ActivityBatcher{
+		Interval: interval,
+		services: make(map[string]*activityEntry),
+		flush: func(ctx context.Context, service Service) error {
+			if !service.Activity.IsZero() {
+				return actions.RecordActivity(ctx, service)
+			}
+			_, err := cache.Refresh(ctx, service.Name, service.Request)
+			return err
+		},
+		now: time.Now,
+		schedule: func(delay time.Duration, f func()) {
+			time.AfterFunc(delay, f)
+		},
+	}
+}
+
+// Record records the activity of the service, it is reported at the latest Interval after the previous report
+func (b *ActivityBatcher) Record(service Service) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	entry, ok := b.services[service.Name]
+	if !ok {
+		entry = &activityEntry{}
+		b.services[service.Name] = entry
+	}
+
+	entry.pending = true
+	if entry.scheduled {
+		b.mu.Unlock()
+		return
+	}
+	entry.scheduled = true
+
+	delay := entry.flushedAt.Add(b.Interval).Sub(b.now())
+	b.mu.Unlock()
+
+	if delay < 0 {
+		delay = 0
+	}
+	b.schedule(delay, func() { b.report(service, entry) })
+}
+
+// ReportedAt returns when the activity of the service was last reported, the zero time if it never was
+func (b *ActivityBatcher) ReportedAt(name string) time.Time {
+	if b == nil {
+		return time.Time{}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if entry, ok := b.services[name]; ok {
+		return entry.reportedAt
+	}
+	return time.Time{}
+}
+
+func (b *ActivityBatcher) report(service Service, entry *activityEntry) {
+	b.mu.Lock()
+	flushedAt := b.now()
+	entry.pending = false
+	entry.flushedAt = flushedAt
+	b.mu.Unlock()
+
+	err := b.flush(context.Background(), service)
+	if err != nil {
+		log.Printf("Could not record the activity of %s: %v", service.Name, err)
+	}
+
+	b.mu.Lock()
+	if err == nil {
+		entry.reportedAt = flushedAt
+	}
+	entry.scheduled = false
+	pending := entry.pending
+	b.mu.Unlock()
+
+	// The activity recorded during the flush is reported with the next one
+	if pending {
+		b.Record(service)
+	}
+}
@@ -0,0 +1,114 @@
+package strategy
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActivityBatcher_Record(t *testing.T) {
+	now := time.Now()
+	var scheduled []time.Duration
+	var pending []func()
+	flushes := 0
+	var flushErr error
+
+	batcher := NewActivityBatcher(10*time.Second, nil, nil)
+	batcher.now = func() time.Time { return now }
+	batcher.schedule = func(delay time.Duration, f func()) {
+		scheduled = append(scheduled, delay)
+		pending = append(pending, f)
+	}
+	batcher.flush = func(ctx context.Context, service Service) error {
+		flushes++
+		return flushErr
+	}
+	run := func() {
+		f := pending[0]
+		pending = pending[1:]
+		f()
+	}
+
+	service := Service{Name: "whoami"}
+
+	// The first activity is reported right away, the following ones are batched
+	batcher.Record(service)
+	batcher.Record(service)
+	assert.Equal(t, []time.Duration{0}, scheduled)
+	run()
+	assert.Equal(t, 1, flushes)
+	assert.Equal(t, now, batcher.ReportedAt("whoami"))
+
+	now = now.Add(2 * time.Second)
+	batcher.Record(service)
+	batcher.Record(service)
+	batcher.Record(service)
+	assert.Equal(t, []time.Duration{0, 8 * time.Second}, scheduled)
+
+	now = now.Add(8 * time.Second)
+	run()
+	assert.Equal(t, 2, flushes)
+	assert.Equal(t, now, batcher.ReportedAt("whoami"))
+
+	// Nothing is reported without activity
+	assert.Len(t, pending, 0)
+
+	// A failed report does not update the last report
+	reportedAt := now
+	now = now.Add(time.Minute)
+	flushErr = errors.New("connection refused")
+	batcher.Record(service)
+	run()
+	assert.Equal(t, 3, flushes)
+	assert.Equal(t, reportedAt, batcher.ReportedAt("whoami"))
+	assert.Equal(t, time.Time{}, batcher.ReportedAt("unknown"))
+}
+
+func TestDynamicStrategy_Active(t *testing.T) {
+	var statusCalls, activityCalls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/activity" {
+			atomic.AddInt32(&activityCalls, 1)
+			return
+		}
+		atomic.AddInt32(&statusCalls, 1)
+		w.Write([]byte("started"))
+	}))
+	defer server.Close()
+
+	services := []Service{{
+		Name:     "whoami",
+		Request:  server.URL,
+		Timeout:  time.Minute,
+		Activity: Operation{Method: http.MethodPost, Request: server.URL + "/activity"},
+	}}
+	cache := NewStatusCache(0, 0)
+	group := GroupPoller{Services: services, Cache: cache, Activity: NewActivityBatcher(time.Minute, NewActions(nil, 0), cache)}
+
+	var forwarded int32
+	dynamic := &DynamicStrategy{
+		Group: group,
+		Next: &ActivityHandler{Group: group, Next: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&forwarded, 1)
+		})},
+	}
+
+	dynamic.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&statusCalls))
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&activityCalls) == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.Eventually(t, group.Active, 5*time.Second, 10*time.Millisecond)
+
+	// Once the activity is reported, the requests are forwarded without calling the ondemand service
+	for i := 0; i < 10; i++ {
+		dynamic.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	}
+	assert.Equal(t, int32(11), atomic.LoadInt32(&forwarded))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&statusCalls))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&activityCalls))
+}
@@ -1,59 +1,65 @@
 package strategy
 
 import (
-	"encoding/json"
-	"fmt"
-	"log"
+	"context"
 	"net/http"
 	"time"
+
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/pages"
 )
 
 type BlockingStrategy struct {
-	Requests           []string
+	Group              GroupPoller
 	Name               string
 	Next               http.Handler
 	Timeout            time.Duration
 	BlockDelay         time.Duration
 	BlockCheckInterval time.Duration
-}
-
-type InternalServerError struct {
-	ServiceName string `json:"serviceName"`
-	Error       string `json:"error"`
+	ErrorPage          *pages.Template
+	Locale             string
+	// AssetsUrl is the URL of the assets of the error page, the assets endpoint at the root of the router when empty
+	AssetsUrl string
 }
 
 // ServeHTTP retrieve the service status
 func (e *BlockingStrategy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	// Started services with a recent activity are not checked again, the request is forwarded without blocking
+	if e.Group.Active() {
+		e.Next.ServeHTTP(rw, req)
+		return
+	}
 
-	for start := time.Now(); time.Since(start) < e.BlockDelay; {
-		notReadyCount := 0
-		for _, request := range e.Requests {
+	ctx, cancel := context.WithTimeout(req.Context(), e.BlockDelay)
+	defer cancel()
 
-			log.Printf("Sending request: %s", request)
-			status, err := getServiceStatus(request)
-			log.Printf("Status: %s", status)
+	var group GroupStatus
 
-			if err != nil {
-				rw.Header().Set("Content-Type", "application/json")
-				rw.WriteHeader(http.StatusInternalServerError)
-				json.NewEncoder(rw).Encode(InternalServerError{ServiceName: e.Name, Error: err.Error()})
-				return
-			}
+	for ctx.Err() == nil {
+		group = e.Group.Poll(ctx)
 
-			if status != "started" {
-				notReadyCount++
-			}
+		if ctx.Err() != nil {
+			break
 		}
-		if notReadyCount == 0 {
+
+		switch group.State() {
+		case StateStarted:
 			// Services all started forward request
 			e.Next.ServeHTTP(rw, req)
 			return
+		case StateFailed:
+			e.errors().Render(rw, req, NewGroupProblem(group))
+			return
 		}
 
-		time.Sleep(e.BlockCheckInterval)
+		select {
+		case <-ctx.Done():
+		case <-time.After(e.BlockCheckInterval):
+		}
 	}
 
-	rw.Header().Set("Content-Type", "application/json")
-	rw.WriteHeader(http.StatusServiceUnavailable)
-	json.NewEncoder(rw).Encode(InternalServerError{ServiceName: e.Name, Error: fmt.Sprintf("Service was unreachable within %s", e.BlockDelay)})
+	e.errors().Render(rw, req, NewWakeTimeoutProblem(e.BlockDelay, group))
+}
+
+func (e *BlockingStrategy) errors() *ErrorRenderer {
+	return &ErrorRenderer{Name: e.Name, ErrorPage: e.ErrorPage, Offers: JSONFirst, Locale: e.Locale, AssetsUrl: e.AssetsUrl}
 }
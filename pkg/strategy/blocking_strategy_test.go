@@ -30,7 +30,7 @@ func TestSingleBlockingStrategy_ServeHTTP(t *testing.T) {
 
 			blockingStrategy := &BlockingStrategy{
 				Name:       "whoami",
-				Requests:   []string{mockServer.URL},
+				Group:      GroupPoller{Services: []Service{{Name: "whoami", Request: mockServer.URL}}},
 				Next:       next,
 				BlockDelay: 1 * time.Second,
 			}
@@ -52,7 +52,8 @@ func TestMultipleBlockingStrategy_ServeHTTP(t *testing.T) {
 		test := test
 		t.Run(test.desc, func(t *testing.T) {
 			t.Parallel()
-			urls := make([]string, len(test.onDemandServiceResponses))
+			release := make(chan struct{})
+			services := make([]Service, len(test.onDemandServiceResponses))
 			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusOK)
 				w.Write([]byte("ok"))
@@ -61,28 +62,41 @@ func TestMultipleBlockingStrategy_ServeHTTP(t *testing.T) {
 			for responseIndex, response := range test.onDemandServiceResponses {
 				response := response
 				mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					response.simulateLatency(r, release)
 					w.WriteHeader(response.status)
 					fmt.Fprint(w, response.body)
 				}))
 
 				defer mockServer.Close()
-				urls[responseIndex] = mockServer.URL
+				services[responseIndex] = Service{Name: fmt.Sprintf("whoami-%d", responseIndex), Request: mockServer.URL}
 			}
-			fmt.Println(urls)
 			blockingStrategy := &BlockingStrategy{
 				Name:       "whoami",
-				Requests:   urls,
+				Group:      GroupPoller{Services: services, Timeout: PollTimeout},
 				Next:       next,
 				BlockDelay: 1 * time.Second,
 			}
 
-			recorder := httptest.NewRecorder()
+			defer close(release)
+
+			recorder := newStrictResponseWriter()
 
 			req := httptest.NewRequest(http.MethodGet, "http://mydomain/whoami", nil)
 
+			start := time.Now()
 			blockingStrategy.ServeHTTP(recorder, req)
 
 			assert.Equal(t, test.expected.blocking, recorder.Code)
+			assert.Empty(t, recorder.violations)
+
+			if recorder.Code == http.StatusInternalServerError {
+				for _, name := range failedServices(test.onDemandServiceResponses) {
+					assert.Contains(t, recorder.Body.String(), name)
+				}
+			}
+			if test.maxLatency > 0 {
+				assert.Less(t, int64(time.Since(start)), int64(test.maxLatency))
+			}
 		})
 	}
 }
@@ -0,0 +1,83 @@
+package strategy
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/pages"
+)
+
+// ProblemClosed is reported while the schedule forbids waking the services up
+const ProblemClosed = "urn:traefik-ondemand-plugin:problem:closed"
+
+// ClosedStrategy serves the requests while the schedule forbids waking the services up: they are forwarded when the
+// group was recently known started, and get the closed page otherwise. The ondemand service is never queried.
+type ClosedStrategy struct {
+	Group      GroupPoller
+	Name       string
+	Next       http.Handler
+	ClosedPage *pages.Template
+	Scheduler  *Scheduler
+	Locale     string
+	// AssetsUrl is the URL of the assets of the closed page, the assets endpoint at the root of the router when empty
+	AssetsUrl string
+}
+
+// ServeHTTP forward the request or serve the closed page until the group can be woken up again
+func (e *ClosedStrategy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if group, ok := e.Group.Peek(); ok && group.State() == StateStarted {
+		e.Next.ServeHTTP(rw, req)
+		return
+	}
+
+	now := e.Scheduler.now()
+	until, _ := e.Scheduler.ClosedUntil()
+
+	retryAfter := int64(math.Ceil(until.Sub(now).Seconds()))
+	if retryAfter < 0 {
+		retryAfter = 0
+	}
+
+	rw.Header().Set("Retry-After", strconv.FormatInt(retryAfter, 10))
+	rw.Header().Set("Cache-Control", "no-store")
+
+	switch negotiate(req.Header.Get("Accept"), HTMLFirst) {
+	case mediaTypeHTML:
+		locale := negotiateLocale(req, e.Locale)
+		rw.Header().Set("Content-Type", "text/html; charset=utf-8")
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		rw.Write([]byte(pages.GetClosedPage(e.ClosedPage, pages.ClosedData{
+			Locale:    locale,
+			Name:      e.Name,
+			Until:     humanizeUntil(locale, until, now.In(until.Location())),
+			AssetsUrl: AssetsURL(req, e.AssetsUrl),
+		})))
+	case mediaTypeProblemJSON, mediaTypeJSON:
+		rw.Header().Set("Content-Type", mediaTypeProblemJSON)
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(rw).Encode(Problem{
+			Type:       ProblemClosed,
+			Title:      "Closed",
+			Status:     http.StatusServiceUnavailable,
+			Detail:     "Service cannot be woken up until " + until.Format(time.RFC3339),
+			Instance:   req.URL.RequestURI(),
+			Middleware: e.Name,
+		})
+	default:
+		rw.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprintf(rw, "Closed: service cannot be woken up until %s\n", until.Format(time.RFC3339))
+	}
+}
+
+// humanizeUntil returns the clock time of until in locale, with its date when it is not the same day as now
+func humanizeUntil(locale string, until time.Time, now time.Time) string {
+	if until.Year() == now.Year() && until.YearDay() == now.YearDay() {
+		return until.Format(pages.Translate(locale, "layout.time"))
+	}
+	return until.Format(pages.Translate(locale, "layout.datetime"))
+}
@@ -0,0 +1,87 @@
+package strategy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/schedule"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClosedStrategy_ServeHTTP(t *testing.T) {
+	closed, err := schedule.ParseWindow("*", "22:00", "08:00")
+	require.NoError(t, err)
+
+	now := time.Date(2021, 6, 1, 23, 0, 0, 0, time.UTC)
+	scheduler := NewScheduler(schedule.Schedule{Location: time.UTC, Closed: []schedule.Window{closed}}, GroupPoller{}, time.Minute)
+	scheduler.now = func() time.Time { return now }
+
+	testCases := []struct {
+		desc                string
+		state               string
+		accept              string
+		expectedStatus      int
+		expectedContentType string
+		expectedBody        string
+	}{
+		{
+			desc:                "closed page",
+			state:               "stopped",
+			accept:              "text/html",
+			expectedStatus:      http.StatusServiceUnavailable,
+			expectedContentType: "text/html; charset=utf-8",
+			expectedBody:        "à partir de 02/06 08:00",
+		},
+		{
+			desc:                "closed problem",
+			state:               "stopped",
+			accept:              "application/json",
+			expectedStatus:      http.StatusServiceUnavailable,
+			expectedContentType: "application/problem+json",
+			expectedBody:        ProblemClosed,
+		},
+		{
+			desc:           "started group is forwarded",
+			state:          "started",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(test.state))
+			}))
+			defer server.Close()
+
+			group := GroupPoller{Services: []Service{{Name: "whoami", Request: server.URL}}, Cache: NewStatusCache(0, 0)}
+			group.Poll(context.Background())
+
+			closedStrategy := &ClosedStrategy{
+				Group:     group,
+				Name:      "whoami",
+				Next:      http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+				Scheduler: scheduler,
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Accept", test.accept)
+			recorder := httptest.NewRecorder()
+			closedStrategy.ServeHTTP(recorder, req)
+
+			assert.Equal(t, test.expectedStatus, recorder.Code)
+			if test.expectedStatus == http.StatusServiceUnavailable {
+				assert.Equal(t, "32400", recorder.Header().Get("Retry-After"))
+				assert.Equal(t, test.expectedContentType, recorder.Header().Get("Content-Type"))
+				assert.Contains(t, recorder.Body.String(), test.expectedBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package strategy
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AssignTiers sets the tier of each service from its dependencies: services without dependencies are in tier 0,
+// the others in the tier following the highest tier of their dependencies.
+// Unknown dependencies and cycles are reported as errors.
+func AssignTiers(services []Service) error {
+	indexes := make(map[string]int, len(services))
+	for index, service := range services {
+		indexes[service.Name] = index
+	}
+
+	for _, service := range services {
+		for _, dependency := range service.DependsOn {
+			if _, ok := indexes[dependency]; !ok {
+				return fmt.Errorf("service %s depends on %s which is not part of the group", service.Name, dependency)
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	marks := make([]int, len(services))
+	var path []string
+
+	var visit func(index int) error
+	visit = func(index int) error {
+		switch marks[index] {
+		case visited:
+			return nil
+		case visiting:
+			cycle := append(path[indexOf(path, services[index].Name):], services[index].Name)
+			return fmt.Errorf("dependency cycle: %s", strings.Join(cycle, " -> "))
+		}
+
+		marks[index] = visiting
+		path = append(path, services[index].Name)
+
+		tier := 0
+		for _, dependency := range services[index].DependsOn {
+			dependencyIndex := indexes[dependency]
+			if err := visit(dependencyIndex); err != nil {
+				return err
+			}
+			if services[dependencyIndex].Tier+1 > tier {
+				tier = services[dependencyIndex].Tier + 1
+			}
+		}
+
+		services[index].Tier = tier
+		path = path[:len(path)-1]
+		marks[index] = visited
+		return nil
+	}
+
+	for index := range services {
+		if err := visit(index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func indexOf(values []string, value string) int {
+	for index, v := range values {
+		if v == value {
+			return index
+		}
+	}
+	return -1
+}
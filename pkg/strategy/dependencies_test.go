@@ -0,0 +1,88 @@
+package strategy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignTiers(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		services      []Service
+		expected      []int
+		expectedError string
+	}{
+		{
+			desc:     "no dependencies",
+			services: []Service{{Name: "web"}, {Name: "db"}},
+			expected: []int{0, 0},
+		},
+		{
+			desc: "chain",
+			services: []Service{
+				{Name: "web", DependsOn: []string{"api"}},
+				{Name: "api", DependsOn: []string{"db"}},
+				{Name: "db"},
+			},
+			expected: []int{2, 1, 0},
+		},
+		{
+			desc: "diamond",
+			services: []Service{
+				{Name: "db"},
+				{Name: "cache"},
+				{Name: "api", DependsOn: []string{"db", "cache"}},
+				{Name: "worker", DependsOn: []string{"db"}},
+				{Name: "web", DependsOn: []string{"api", "worker"}},
+			},
+			expected: []int{0, 0, 1, 1, 2},
+		},
+		{
+			desc: "unknown dependency",
+			services: []Service{
+				{Name: "web", DependsOn: []string{"db"}},
+			},
+			expectedError: "service web depends on db which is not part of the group",
+		},
+		{
+			desc: "self dependency",
+			services: []Service{
+				{Name: "db", DependsOn: []string{"db"}},
+			},
+			expectedError: "dependency cycle: db -> db",
+		},
+		{
+			desc: "cycle",
+			services: []Service{
+				{Name: "web", DependsOn: []string{"api"}},
+				{Name: "api", DependsOn: []string{"db"}},
+				{Name: "db", DependsOn: []string{"api"}},
+			},
+			expectedError: "dependency cycle: api -> db -> api",
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := AssignTiers(test.services)
+
+			if len(test.expectedError) != 0 {
+				require.Error(t, err)
+				assert.Equal(t, test.expectedError, err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			tiers := make([]int, len(test.services))
+			for index, service := range test.services {
+				tiers[index] = service.Tier
+			}
+			assert.Equal(t, test.expected, tiers)
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package strategy
 
 import (
-	"log"
 	"net/http"
 	"time"
 
@@ -9,45 +8,109 @@ import (
 )
 
 type DynamicStrategy struct {
-	Requests    []string
+	Group       GroupPoller
 	Name        string
 	Next        http.Handler
 	Timeout     time.Duration
-	LoadingPage string
-	ErrorPage   string
+	LoadingPage *pages.Template
+	ErrorPage   *pages.Template
+	// Locale is the language of the pages when the client accepts none of the translated ones
+	Locale string
+	// AssetsUrl is the URL of the assets of the pages, the assets endpoint at the root of the router when empty
+	AssetsUrl string
+	// RefreshInterval is the interval the loading page is reloaded at without the events stream, the default one when zero
+	RefreshInterval time.Duration
+}
+
+// dynamicState is the final state of a request handled by the dynamic strategy,
+// it is only known once the result of every service of the group is known
+type dynamicState int
+
+const (
+	// dynamicForwarding forwards the request, every service is started
+	dynamicForwarding dynamicState = iota
+	// dynamicLoading serves the loading page, some services are still starting
+	dynamicLoading
+	// dynamicFailing serves the error page, some services failed
+	dynamicFailing
+)
+
+// nextDynamicState returns the final state from the aggregated results
+func nextDynamicState(group GroupStatus) dynamicState {
+	switch group.State() {
+	case StateStarted:
+		return dynamicForwarding
+	case StateStarting:
+		return dynamicLoading
+	default:
+		return dynamicFailing
+	}
 }
 
 // ServeHTTP retrieve the service status
 func (e *DynamicStrategy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
-	started := make([]bool, len(e.Requests))
-	notReadyCount := 0
-	for requestIndex, request := range e.Requests {
-		log.Printf("Sending request: %s", request)
-		status, err := getServiceStatus(request)
-		log.Printf("Status: %s", status)
-
-		if err != nil {
-			rw.WriteHeader(http.StatusInternalServerError)
-			rw.Write([]byte(pages.GetErrorPage(e.ErrorPage, e.Name, err.Error())))
-		}
-
-		if status == "started" {
-			started[requestIndex] = true
-		} else if status == "starting" {
-			started[requestIndex] = false
-			notReadyCount++
-		} else {
-			// Error
-			rw.WriteHeader(http.StatusInternalServerError)
-			rw.Write([]byte(pages.GetErrorPage(e.ErrorPage, e.Name, status)))
-		}
+	// The services are known to be started, their status is not checked on every request
+	if e.Group.Active() {
+		e.Next.ServeHTTP(rw, req)
+		return
 	}
-	if notReadyCount == 0 {
+
+	group := e.Group.Poll(req.Context())
+	state := nextDynamicState(group)
+
+	// Exactly one response is produced from the final state
+	switch state {
+	case dynamicForwarding:
 		// All services are ready, forward request
 		e.Next.ServeHTTP(rw, req)
-	} else {
+	case dynamicLoading:
 		// Services still starting, notify client
 		rw.WriteHeader(http.StatusAccepted)
-		rw.Write([]byte(pages.GetLoadingPage(e.LoadingPage, e.Name, e.Timeout)))
+		rw.Write([]byte(pages.GetLoadingPage(e.LoadingPage, e.Timeout, pages.LoadingData{
+			Locale:          negotiateLocale(req, e.Locale),
+			Name:            e.Name,
+			Middleware:      e.Name,
+			Services:        toServicesData(group),
+			Tier:            group.CurrentTier() + 1,
+			Tiers:           group.Tiers(),
+			EventsUrl:       ReservedURL(req, "events"),
+			AssetsUrl:       AssetsURL(req, e.AssetsUrl),
+			Request:         toRequestData(req),
+			RetryUrl:        req.URL.RequestURI(),
+			RefreshInterval: e.RefreshInterval,
+			Elapsed:         group.Elapsed(time.Now()),
+			Remaining:       group.Remaining(),
+		})))
+	case dynamicFailing:
+		e.errors().Render(rw, req, NewGroupProblem(group))
+	}
+}
+
+func (e *DynamicStrategy) errors() *ErrorRenderer {
+	return &ErrorRenderer{Name: e.Name, ErrorPage: e.ErrorPage, Offers: HTMLFirst, Locale: e.Locale, AssetsUrl: e.AssetsUrl}
+}
+
+func toServicesData(group GroupStatus) []pages.ServiceData {
+	services := make([]pages.ServiceData, 0, len(group.Results))
+	for _, result := range group.Results {
+		service := pages.ServiceData{
+			Name:  result.Service.Name,
+			State: result.State(),
+			Tier:  result.Service.Tier + 1,
+		}
+		if status := result.Status; status != nil {
+			service.Progress = status.Progress()
+			service.Message = status.Message
+			service.ReadyReplicas = status.ReadyReplicas
+			service.DesiredReplicas = status.DesiredReplicas
+			service.EstimatedTimeToReady = status.EstimatedTimeToReady
+			service.LastTransitionTime = status.LastTransitionTime
+		}
+		services = append(services, service)
 	}
+	return services
+}
+
+func toRequestData(req *http.Request) pages.RequestData {
+	return pages.RequestData{Method: req.Method, Host: req.Host, Path: req.URL.Path}
 }
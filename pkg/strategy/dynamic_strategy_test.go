@@ -2,11 +2,17 @@ package strategy
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/pages"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSingleDynamicStrategy_ServeHTTP(t *testing.T) {
@@ -25,9 +31,9 @@ func TestSingleDynamicStrategy_ServeHTTP(t *testing.T) {
 			defer mockServer.Close()
 
 			dynamicStrategy := &DynamicStrategy{
-				Name:     "whoami",
-				Requests: []string{mockServer.URL},
-				Next:     next,
+				Name:  "whoami",
+				Group: GroupPoller{Services: []Service{{Name: "whoami", Request: mockServer.URL}}},
+				Next:  next,
 			}
 
 			recorder := httptest.NewRecorder()
@@ -47,31 +53,127 @@ func TestMultipleDynamicStrategy_ServeHTTP(t *testing.T) {
 		t.Run(test.desc, func(t *testing.T) {
 			t.Parallel()
 
-			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte("ok"))
+			})
 
-			urls := make([]string, len(test.onDemandServiceResponses))
+			release := make(chan struct{})
+			services := make([]Service, len(test.onDemandServiceResponses))
 			for responseIndex, response := range test.onDemandServiceResponses {
 				response := response
 				mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					response.simulateLatency(r, release)
 					fmt.Fprint(w, response.body)
 				}))
 				defer mockServer.Close()
 
-				urls[responseIndex] = mockServer.URL
+				services[responseIndex] = Service{Name: fmt.Sprintf("whoami-%d", responseIndex), Request: mockServer.URL}
 			}
 			dynamicStrategy := &DynamicStrategy{
-				Name:     "whoami",
-				Requests: urls,
-				Next:     next,
+				Name:  "whoami",
+				Group: GroupPoller{Services: services, Timeout: PollTimeout},
+				Next:  next,
 			}
 
-			recorder := httptest.NewRecorder()
+			defer close(release)
+
+			recorder := newStrictResponseWriter()
 
 			req := httptest.NewRequest(http.MethodGet, "http://mydomain/whoami", nil)
 
+			start := time.Now()
 			dynamicStrategy.ServeHTTP(recorder, req)
 
 			assert.Equal(t, test.expected.dynamic, recorder.Code)
+			assert.Empty(t, recorder.violations)
+
+			switch recorder.Code {
+			case http.StatusOK:
+				assert.Equal(t, "ok", recorder.Body.String())
+			case http.StatusAccepted:
+				assert.Contains(t, recorder.Body.String(), "<!doctype html>")
+			default:
+				for _, name := range failedServices(test.onDemandServiceResponses) {
+					assert.Contains(t, recorder.Body.String(), name)
+				}
+				assert.Equal(t, 1, strings.Count(recorder.Body.String(), "<!doctype html>"))
+			}
+			if test.maxLatency > 0 {
+				assert.Less(t, int64(time.Since(start)), int64(test.maxLatency))
+			}
 		})
 	}
 }
+
+func TestDynamicStrategy_ServeHTTP_Locale(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		acceptLanguage string
+		locale         string
+		expected       string
+	}{
+		{desc: "default locale", acceptLanguage: "", locale: "", expected: "Réveil en cours..."},
+		{desc: "configured locale", acceptLanguage: "de", locale: "en", expected: "Waking up..."},
+		{desc: "accepted language", acceptLanguage: "en-US,en;q=0.9", locale: "fr", expected: "Waking up..."},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, "starting")
+			}))
+			defer mockServer.Close()
+
+			dynamicStrategy := &DynamicStrategy{
+				Name:   "whoami",
+				Group:  GroupPoller{Services: []Service{{Name: "whoami", Request: mockServer.URL}}},
+				Next:   http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+				Locale: test.locale,
+			}
+
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "http://mydomain/whoami", nil)
+			req.Header.Set("Accept-Language", test.acceptLanguage)
+
+			dynamicStrategy.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusAccepted, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), test.expected)
+		})
+	}
+}
+
+func TestDynamicStrategy_ServeHTTP_LoadingData(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "loading.html")
+	content := `{{ .Middleware }} {{ .Request.Host }}{{ .Request.Path }} {{ .RetryUrl }} {{ .TimeoutDuration }} {{ seconds .RefreshInterval }}{{ range .Services }} {{ .Name }}:{{ .State }}{{ end }}`
+	require.NoError(t, ioutil.WriteFile(file, []byte(content), 0644))
+
+	loadingPage, err := pages.NewLoadingTemplate(file)
+	require.NoError(t, err)
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "starting")
+	}))
+	defer mockServer.Close()
+
+	dynamicStrategy := &DynamicStrategy{
+		Name:            "whoami",
+		Group:           GroupPoller{Services: []Service{{Name: "whoami", Request: mockServer.URL}}},
+		Next:            http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+		Timeout:         time.Hour,
+		LoadingPage:     loadingPage,
+		RefreshInterval: 10 * time.Second,
+	}
+
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "http://mydomain/whoami?page=2", nil)
+
+	dynamicStrategy.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusAccepted, recorder.Code)
+	assert.Equal(t, "whoami mydomain/whoami /whoami?page=2 1h0m0s 10 whoami:starting", recorder.Body.String())
+}
@@ -0,0 +1,367 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// ServiceResult is the outcome of a status check for a single service of the group
+type ServiceResult struct {
+	Service Service
+	Status  *ServiceStatus
+	Err     error
+	// Waiting reports that the service was not checked, nor woken up, because its dependencies are not started yet
+	Waiting   bool
+	CheckedAt time.Time
+}
+
+// State returns "failed" if the check failed, "waiting" if its dependencies are not started yet, "unknown" if the
+// service was not checked, "stopped" if it is asleep, "started" if the service is ready to serve requests, "starting" otherwise
+func (r ServiceResult) State() string {
+	switch {
+	case r.Err != nil:
+		return StateFailed
+	case r.Waiting:
+		return StateWaiting
+	case r.Status == nil:
+		return StateUnknown
+	case r.Status.IsStopped():
+		return StateStopped
+	case r.Status.IsStarted():
+		return StateStarted
+	case r.Service.ReadyReplicas > 0 && r.Status.IsStarting() && r.Status.ReadyReplicas >= r.Service.ReadyReplicas:
+		return StateStarted
+	default:
+		return StateStarting
+	}
+}
+
+// ReadinessPolicy decides when a group is ready to receive requests, the zero value requires every service
+type ReadinessPolicy struct {
+	// Required are the services taken into account, every service of the group when empty
+	Required []string
+	// Quorum is the number of required services which must be started, every required service when 0
+	Quorum int
+}
+
+func (p ReadinessPolicy) requires(name string) bool {
+	if len(p.Required) == 0 {
+		return true
+	}
+	for _, required := range p.Required {
+		if required == name {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupStatus aggregates the status of every service of the group, in the configured order
+type GroupStatus struct {
+	Results []ServiceResult
+	Policy  ReadinessPolicy
+}
+
+// State returns "started" when the quorum of required services is started, "failed" when it cannot be reached
+// anymore because of failed services, "unknown" when a required service was not checked, "starting" otherwise.
+// The other services keep waking up in the background.
+func (g GroupStatus) State() string {
+	required, started, failed, unknown := 0, 0, 0, 0
+	for _, result := range g.Results {
+		if !g.Policy.requires(result.Service.Name) {
+			continue
+		}
+		required++
+		switch result.State() {
+		case StateStarted:
+			started++
+		case StateFailed:
+			failed++
+		case StateUnknown:
+			unknown++
+		}
+	}
+
+	quorum := g.Policy.Quorum
+	if quorum <= 0 || quorum > required {
+		quorum = required
+	}
+
+	switch {
+	case started >= quorum:
+		return StateStarted
+	case required-failed < quorum:
+		return StateFailed
+	case unknown > 0:
+		return StateUnknown
+	default:
+		return StateStarting
+	}
+}
+
+// Tiers returns the number of startup tiers of the group
+func (g GroupStatus) Tiers() int {
+	tiers := 0
+	for _, result := range g.Results {
+		if result.Service.Tier+1 > tiers {
+			tiers = result.Service.Tier + 1
+		}
+	}
+	return tiers
+}
+
+// CurrentTier returns the lowest tier which is not started yet, the last tier when the whole group is started
+func (g GroupStatus) CurrentTier() int {
+	current := g.Tiers() - 1
+	for _, result := range g.Results {
+		if result.State() != StateStarted && result.Service.Tier < current {
+			current = result.Service.Tier
+		}
+	}
+	if current < 0 {
+		return 0
+	}
+	return current
+}
+
+// Elapsed returns the time since the first starting service entered the starting state,
+// zero when no starting service reported its transition time
+func (g GroupStatus) Elapsed(now time.Time) time.Duration {
+	var since time.Time
+	for _, result := range g.Results {
+		status := result.Status
+		if status == nil || !status.IsStarting() || status.LastTransitionTime.IsZero() {
+			continue
+		}
+		if since.IsZero() || status.LastTransitionTime.Before(since) {
+			since = status.LastTransitionTime
+		}
+	}
+	if since.IsZero() || now.Before(since) {
+		return 0
+	}
+	return now.Sub(since)
+}
+
+// Remaining returns the longest estimated time before a service not started yet is ready, zero when unknown
+func (g GroupStatus) Remaining() time.Duration {
+	remaining := time.Duration(0)
+	for _, result := range g.Results {
+		status := result.Status
+		if status == nil || status.IsStarted() {
+			continue
+		}
+		if status.EstimatedTimeToReady > remaining {
+			remaining = status.EstimatedTimeToReady
+		}
+	}
+	return remaining
+}
+
+// Failures returns the results of the services that failed
+func (g GroupStatus) Failures() []ServiceResult {
+	var failures []ServiceResult
+	for _, result := range g.Results {
+		if result.Err != nil {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+// GroupPoller checks the status of the services of a group concurrently
+type GroupPoller struct {
+	Services []Service
+	Cache    *StatusCache
+	// Workers bounds the number of concurrent status checks, 0 means one per service
+	Workers int
+	// Timeout bounds the time spent checking the whole group, 0 means no bound
+	Timeout time.Duration
+	// Policy decides when the group is ready
+	Policy ReadinessPolicy
+	// Actions sends the wake operations
+	Actions *Actions
+	// Activity reports the activity of the services
+	Activity *ActivityBatcher
+	// Wake sends the wake operation of the stopped services, the poller only observes the services otherwise
+	Wake bool
+	// CacheOnly answers Poll with the last known statuses, the ondemand service is never queried.
+	// A status call wakes the services up without split operations, the poller can neither observe them then.
+	CacheOnly bool
+}
+
+// Poll returns the status of every service, services not answering before the deadline are reported in error
+func (p *GroupPoller) Poll(ctx context.Context) GroupStatus {
+	if p.CacheOnly {
+		return p.Cached()
+	}
+
+	if p.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.Timeout)
+		defer cancel()
+	}
+
+	results := make([]ServiceResult, len(p.Services))
+
+	// Tiers are woken up in order, a tier is only checked once every service of the previous tiers is started
+	for tier := 0; ; tier++ {
+		var indexes []int
+		for index, service := range p.Services {
+			if service.Tier == tier {
+				indexes = append(indexes, index)
+			}
+		}
+
+		if len(indexes) == 0 {
+			break
+		}
+
+		p.checkAll(ctx, indexes, results)
+
+		if !tierStarted(results, indexes) {
+			for index, service := range p.Services {
+				if service.Tier > tier {
+					results[index] = ServiceResult{Service: service, Waiting: true, CheckedAt: time.Now()}
+				}
+			}
+			break
+		}
+	}
+
+	return GroupStatus{Results: results, Policy: p.Policy}
+}
+
+// Peek returns the last known status of every service without querying the ondemand service,
+// false when a service status is unknown. A status received a service Timeout ago or more is unknown,
+// the service may have been stopped for inactivity since.
+func (p *GroupPoller) Peek() (GroupStatus, bool) {
+	group := p.Cached()
+	for _, result := range group.Results {
+		if result.State() == StateUnknown {
+			return GroupStatus{}, false
+		}
+	}
+	return group, true
+}
+
+// Cached returns the last known status of every service without querying the ondemand service,
+// the services with no status received less than a service Timeout ago are unknown
+func (p *GroupPoller) Cached() GroupStatus {
+	now := time.Now()
+	results := make([]ServiceResult, len(p.Services))
+	for index, service := range p.Services {
+		status := p.Cache.Peek(service.Name)
+		if status == nil || (service.Timeout > 0 && now.Sub(status.CheckedAt) >= service.Timeout) {
+			results[index] = ServiceResult{Service: service}
+			continue
+		}
+		results[index] = ServiceResult{Service: service, Status: status, Err: status.Err(), CheckedAt: status.CheckedAt}
+	}
+	return GroupStatus{Results: results, Policy: p.Policy}
+}
+
+// checkAll checks the services at indexes concurrently, with at most Workers checks at a time
+func (p *GroupPoller) checkAll(ctx context.Context, indexes []int, results []ServiceResult) {
+	workers := p.Workers
+	if workers <= 0 || workers > len(indexes) {
+		workers = len(indexes)
+	}
+
+	queue := make(chan int)
+	done := make(chan struct{})
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			for index := range queue {
+				results[index] = p.check(ctx, p.Services[index])
+			}
+			done <- struct{}{}
+		}()
+	}
+
+	for _, index := range indexes {
+		queue <- index
+	}
+	close(queue)
+
+	for i := 0; i < workers; i++ {
+		<-done
+	}
+}
+
+func tierStarted(results []ServiceResult, indexes []int) bool {
+	for _, index := range indexes {
+		if results[index].State() != StateStarted {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *GroupPoller) check(ctx context.Context, service Service) ServiceResult {
+	if ctx.Err() != nil {
+		return ServiceResult{Service: service, Err: p.deadlineError(ctx), CheckedAt: time.Now()}
+	}
+
+	log.Printf("Sending request: %s", service.Request)
+	status, err := p.Cache.Get(ctx, service.Name, service.Request)
+
+	if err != nil {
+		if ctx.Err() != nil {
+			err = p.deadlineError(ctx)
+		}
+		return ServiceResult{Service: service, Err: err, CheckedAt: time.Now()}
+	}
+
+	log.Printf("Status: %s", status.State)
+
+	if status.IsStopped() && p.Wake && !service.Wake.IsZero() {
+		if err := p.Actions.Wake(ctx, service); err != nil {
+			return ServiceResult{Service: service, Status: status, Err: err, CheckedAt: status.CheckedAt}
+		}
+
+		woken := *status
+		woken.State = StateStarting
+		status = &woken
+	}
+
+	return ServiceResult{Service: service, Status: status, Err: status.Err(), CheckedAt: status.CheckedAt}
+}
+
+// RecordActivity records the activity of every service, it is reported to the ondemand service in the background
+func (p *GroupPoller) RecordActivity() {
+	for _, service := range p.Services {
+		p.Activity.Record(service)
+	}
+}
+
+// Active reports, without querying the ondemand service, that the group was last known started and that the activity
+// of every service was reported during the first half of its timeout, so that none can have been stopped since
+func (p *GroupPoller) Active() bool {
+	if p.Activity == nil {
+		return false
+	}
+
+	group, ok := p.Peek()
+	if !ok || group.State() != StateStarted {
+		return false
+	}
+
+	now := p.Activity.now()
+	for _, service := range p.Services {
+		if now.Sub(p.Activity.ReportedAt(service.Name)) >= service.Timeout/2 {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *GroupPoller) deadlineError(ctx context.Context) error {
+	if p.Timeout == 0 {
+		return &UnreachableError{Err: ctx.Err()}
+	}
+	return &UnreachableError{Err: fmt.Errorf("no status received from the ondemand service within %s", p.Timeout)}
+}
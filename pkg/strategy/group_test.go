@@ -0,0 +1,282 @@
+package strategy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupStatus_State(t *testing.T) {
+	started := &ServiceStatus{State: StateStarted}
+	starting := &ServiceStatus{State: StateStarting}
+
+	testCases := []struct {
+		desc     string
+		results  []ServiceResult
+		expected string
+	}{
+		{
+			desc:     "all started",
+			results:  []ServiceResult{{Status: started}, {Status: started}},
+			expected: StateStarted,
+		},
+		{
+			desc:     "one starting",
+			results:  []ServiceResult{{Status: started}, {Status: starting}},
+			expected: StateStarting,
+		},
+		{
+			desc:     "one failed",
+			results:  []ServiceResult{{Status: starting}, {Err: errors.New("unreachable")}, {Status: started}},
+			expected: StateFailed,
+		},
+		{
+			desc:     "one unknown",
+			results:  []ServiceResult{{Status: started}, {}},
+			expected: StateUnknown,
+		},
+		{
+			desc:     "one unknown and one failed",
+			results:  []ServiceResult{{}, {Err: errors.New("unreachable")}},
+			expected: StateFailed,
+		},
+		{
+			desc: "enough ready replicas",
+			results: []ServiceResult{
+				{Status: started},
+				{Service: Service{ReadyReplicas: 2}, Status: &ServiceStatus{State: StateStarting, ReadyReplicas: 2, DesiredReplicas: 3}},
+			},
+			expected: StateStarted,
+		},
+		{
+			desc: "not enough ready replicas",
+			results: []ServiceResult{
+				{Status: started},
+				{Service: Service{ReadyReplicas: 2}, Status: &ServiceStatus{State: StateStarting, ReadyReplicas: 1, DesiredReplicas: 3}},
+			},
+			expected: StateStarting,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, GroupStatus{Results: test.results}.State())
+		})
+	}
+}
+
+func TestGroupStatus_State_Policy(t *testing.T) {
+	results := func(states ...string) []ServiceResult {
+		names := []string{"web", "api", "search"}
+		results := make([]ServiceResult, len(states))
+		for index, state := range states {
+			results[index] = ServiceResult{Service: Service{Name: names[index]}, Status: &ServiceStatus{State: state}}
+			if state == StateFailed {
+				results[index] = ServiceResult{Service: Service{Name: names[index]}, Err: errors.New("image not found")}
+			}
+		}
+		return results
+	}
+
+	testCases := []struct {
+		desc     string
+		policy   ReadinessPolicy
+		results  []ServiceResult
+		expected string
+	}{
+		{
+			desc:     "all with one starting",
+			policy:   ReadinessPolicy{},
+			results:  results(StateStarted, StateStarted, StateStarting),
+			expected: StateStarting,
+		},
+		{
+			desc:     "any with one started",
+			policy:   ReadinessPolicy{Quorum: 1},
+			results:  results(StateStarting, StateStarted, StateStarting),
+			expected: StateStarted,
+		},
+		{
+			desc:     "any with one failed and one starting",
+			policy:   ReadinessPolicy{Quorum: 1},
+			results:  results(StateFailed, StateStarting, StateStarting),
+			expected: StateStarting,
+		},
+		{
+			desc:     "any with all failed",
+			policy:   ReadinessPolicy{Quorum: 1},
+			results:  results(StateFailed, StateFailed, StateFailed),
+			expected: StateFailed,
+		},
+		{
+			desc:     "quorum reached",
+			policy:   ReadinessPolicy{Quorum: 2},
+			results:  results(StateStarted, StateFailed, StateStarted),
+			expected: StateStarted,
+		},
+		{
+			desc:     "quorum still reachable",
+			policy:   ReadinessPolicy{Quorum: 2},
+			results:  results(StateStarted, StateFailed, StateStarting),
+			expected: StateStarting,
+		},
+		{
+			desc:     "quorum unreachable",
+			policy:   ReadinessPolicy{Quorum: 2},
+			results:  results(StateStarting, StateFailed, StateFailed),
+			expected: StateFailed,
+		},
+		{
+			desc:     "required services started, sidecar lagging",
+			policy:   ReadinessPolicy{Required: []string{"web", "api"}},
+			results:  results(StateStarted, StateStarted, StateStarting),
+			expected: StateStarted,
+		},
+		{
+			desc:     "required services started, sidecar failed",
+			policy:   ReadinessPolicy{Required: []string{"web", "api"}},
+			results:  results(StateStarted, StateStarted, StateFailed),
+			expected: StateStarted,
+		},
+		{
+			desc:     "required service failed",
+			policy:   ReadinessPolicy{Required: []string{"web", "api"}},
+			results:  results(StateStarted, StateFailed, StateStarted),
+			expected: StateFailed,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, GroupStatus{Results: test.results, Policy: test.policy}.State())
+		})
+	}
+}
+
+func TestGroupPoller_Workers(t *testing.T) {
+	var inflight, maxInflight int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inflight, 1)
+		for {
+			max := atomic.LoadInt32(&maxInflight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInflight, max, current) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+		atomic.AddInt32(&inflight, -1)
+		fmt.Fprint(w, "started")
+	}))
+	defer mockServer.Close()
+
+	services := make([]Service, 10)
+	for i := range services {
+		services[i] = Service{Name: fmt.Sprintf("whoami-%d", i), Request: mockServer.URL}
+	}
+
+	poller := &GroupPoller{Services: services, Workers: 3}
+	group := poller.Poll(context.Background())
+
+	assert.Equal(t, StateStarted, group.State())
+	assert.Len(t, group.Results, 10)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&maxInflight))
+}
+
+func TestGroupPoller_CacheOnly(t *testing.T) {
+	var calls int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		fmt.Fprint(w, "started")
+	}))
+	defer mockServer.Close()
+
+	poller := GroupPoller{
+		Services: []Service{{Name: "whoami", Request: mockServer.URL, Timeout: time.Minute}},
+		Cache:    NewStatusCache(time.Second, time.Minute),
+	}
+	cached := poller
+	cached.CacheOnly = true
+
+	group := cached.Poll(context.Background())
+	assert.Equal(t, StateUnknown, group.State())
+	assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+
+	poller.Poll(context.Background())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	group = cached.Poll(context.Background())
+	assert.Equal(t, StateStarted, group.State())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestGroupPoller_Tiers(t *testing.T) {
+	var dbState atomic.Value
+	dbState.Store("starting")
+	var apiCalls int32
+
+	db := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, dbState.Load())
+	}))
+	defer db.Close()
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&apiCalls, 1)
+		fmt.Fprint(w, "starting")
+	}))
+	defer api.Close()
+
+	services := []Service{
+		{Name: "api", Request: api.URL, DependsOn: []string{"db"}},
+		{Name: "db", Request: db.URL},
+	}
+	assert.NoError(t, AssignTiers(services))
+
+	poller := &GroupPoller{Services: services}
+
+	// The api is neither checked nor woken up while the db is starting
+	group := poller.Poll(context.Background())
+	assert.Equal(t, StateStarting, group.State())
+	assert.Equal(t, StateWaiting, group.Results[0].State())
+	assert.Equal(t, StateStarting, group.Results[1].State())
+	assert.Equal(t, 0, group.CurrentTier())
+	assert.Equal(t, 2, group.Tiers())
+	assert.Equal(t, int32(0), atomic.LoadInt32(&apiCalls))
+
+	// Once the db is started, the next tier is woken up
+	dbState.Store("started")
+	group = poller.Poll(context.Background())
+	assert.Equal(t, StateStarting, group.State())
+	assert.Equal(t, StateStarting, group.Results[0].State())
+	assert.Equal(t, StateStarted, group.Results[1].State())
+	assert.Equal(t, 1, group.CurrentTier())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&apiCalls))
+}
+
+func TestGroupStatus_Elapsed_Remaining(t *testing.T) {
+	now := time.Now()
+	group := GroupStatus{Results: []ServiceResult{
+		{Service: Service{Name: "api"}, Status: &ServiceStatus{State: "starting", LastTransitionTime: now.Add(-time.Minute), EstimatedTimeToReady: 30 * time.Second}},
+		{Service: Service{Name: "db"}, Status: &ServiceStatus{State: "starting", LastTransitionTime: now.Add(-2 * time.Minute), EstimatedTimeToReady: 10 * time.Second}},
+		{Service: Service{Name: "cache"}, Status: &ServiceStatus{State: "started", LastTransitionTime: now.Add(-time.Hour), EstimatedTimeToReady: time.Hour}},
+		{Service: Service{Name: "search"}, Err: errors.New("unreachable")},
+	}}
+
+	assert.Equal(t, 2*time.Minute, group.Elapsed(now))
+	assert.Equal(t, 30*time.Second, group.Remaining())
+
+	assert.Equal(t, time.Duration(0), GroupStatus{}.Elapsed(now))
+	assert.Equal(t, time.Duration(0), GroupStatus{}.Remaining())
+}
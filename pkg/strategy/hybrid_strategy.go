@@ -0,0 +1,61 @@
+package strategy
+
+import (
+	"net/http"
+	"strings"
+)
+
+// HybridRules decide which requests get the loading page, every other request blocks until the services are ready
+type HybridRules struct {
+	// Methods allowed to get the loading page
+	Methods []string
+	// Accept media types announcing a browser, e.g. text/html
+	Accept []string
+	// FetchModes of the Sec-Fetch-Mode header allowed to get the loading page, e.g. navigate
+	FetchModes []string
+	// RequestedWith values of the X-Requested-With header forcing to block, e.g. XMLHttpRequest
+	RequestedWith []string
+}
+
+// WantsLoadingPage reports whether the request comes from a browser navigating to the service
+func (r HybridRules) WantsLoadingPage(req *http.Request) bool {
+	if !containsFold(r.Methods, req.Method) {
+		return false
+	}
+
+	if requestedWith := req.Header.Get("X-Requested-With"); len(requestedWith) != 0 && containsFold(r.RequestedWith, requestedWith) {
+		return false
+	}
+
+	// Sec-Fetch-Mode is only sent by browsers and tells exactly how the request was made
+	if fetchMode := req.Header.Get("Sec-Fetch-Mode"); len(fetchMode) != 0 {
+		return containsFold(r.FetchModes, fetchMode)
+	}
+
+	return acceptsExplicitly(req.Header.Get("Accept"), r.Accept)
+}
+
+// HybridStrategy serves the loading page to browsers and blocks every other client
+type HybridStrategy struct {
+	Dynamic  *DynamicStrategy
+	Blocking *BlockingStrategy
+	Rules    HybridRules
+}
+
+// ServeHTTP retrieve the service status
+func (e *HybridStrategy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if e.Rules.WantsLoadingPage(req) {
+		e.Dynamic.ServeHTTP(rw, req)
+	} else {
+		e.Blocking.ServeHTTP(rw, req)
+	}
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(strings.TrimSpace(v), value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,188 @@
+package strategy
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var defaultHybridRules = HybridRules{
+	Methods:       []string{http.MethodGet},
+	Accept:        []string{"text/html", "application/xhtml+xml"},
+	FetchModes:    []string{"navigate"},
+	RequestedWith: []string{"XMLHttpRequest"},
+}
+
+func TestHybridRules_WantsLoadingPage(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		rules    HybridRules
+		method   string
+		headers  map[string]string
+		expected bool
+	}{
+		{
+			desc:     "browser navigation",
+			rules:    defaultHybridRules,
+			method:   http.MethodGet,
+			headers:  map[string]string{"Accept": "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8", "Sec-Fetch-Mode": "navigate"},
+			expected: true,
+		},
+		{
+			desc:     "browser navigation without fetch metadata",
+			rules:    defaultHybridRules,
+			method:   http.MethodGet,
+			headers:  map[string]string{"Accept": "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8"},
+			expected: true,
+		},
+		{
+			desc:     "fetch from a page",
+			rules:    defaultHybridRules,
+			method:   http.MethodGet,
+			headers:  map[string]string{"Accept": "*/*", "Sec-Fetch-Mode": "cors"},
+			expected: false,
+		},
+		{
+			desc:     "fetch accepting html",
+			rules:    defaultHybridRules,
+			method:   http.MethodGet,
+			headers:  map[string]string{"Accept": "text/html", "Sec-Fetch-Mode": "same-origin"},
+			expected: false,
+		},
+		{
+			desc:     "jquery xhr",
+			rules:    defaultHybridRules,
+			method:   http.MethodGet,
+			headers:  map[string]string{"Accept": "text/html, */*; q=0.01", "X-Requested-With": "XMLHttpRequest"},
+			expected: false,
+		},
+		{
+			desc:     "api client",
+			rules:    defaultHybridRules,
+			method:   http.MethodGet,
+			headers:  map[string]string{"Accept": "application/json"},
+			expected: false,
+		},
+		{
+			desc:     "curl",
+			rules:    defaultHybridRules,
+			method:   http.MethodGet,
+			headers:  map[string]string{"Accept": "*/*"},
+			expected: false,
+		},
+		{
+			desc:     "no accept header",
+			rules:    defaultHybridRules,
+			method:   http.MethodGet,
+			expected: false,
+		},
+		{
+			desc:     "html refused",
+			rules:    defaultHybridRules,
+			method:   http.MethodGet,
+			headers:  map[string]string{"Accept": "text/html;q=0, application/json"},
+			expected: false,
+		},
+		{
+			desc:     "form submission",
+			rules:    defaultHybridRules,
+			method:   http.MethodPost,
+			headers:  map[string]string{"Accept": "text/html", "Sec-Fetch-Mode": "navigate"},
+			expected: false,
+		},
+		{
+			desc:     "custom methods",
+			rules:    HybridRules{Methods: []string{"GET", "HEAD"}, Accept: []string{"text/html"}},
+			method:   http.MethodHead,
+			headers:  map[string]string{"Accept": "text/html"},
+			expected: true,
+		},
+		{
+			desc:     "custom accept",
+			rules:    HybridRules{Methods: []string{"GET"}, Accept: []string{"text/plain"}},
+			method:   http.MethodGet,
+			headers:  map[string]string{"Accept": "text/plain"},
+			expected: true,
+		},
+		{
+			desc:     "custom fetch modes",
+			rules:    HybridRules{Methods: []string{"GET"}, FetchModes: []string{"navigate", "no-cors"}},
+			method:   http.MethodGet,
+			headers:  map[string]string{"Sec-Fetch-Mode": "no-cors"},
+			expected: true,
+		},
+		{
+			desc:     "requested with not configured",
+			rules:    HybridRules{Methods: []string{"GET"}, Accept: []string{"text/html"}},
+			method:   http.MethodGet,
+			headers:  map[string]string{"Accept": "text/html", "X-Requested-With": "XMLHttpRequest"},
+			expected: true,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(test.method, "http://mydomain/whoami", nil)
+			for key, value := range test.headers {
+				req.Header.Set(key, value)
+			}
+
+			assert.Equal(t, test.expected, test.rules.WantsLoadingPage(req))
+		})
+	}
+}
+
+func TestHybridStrategy_ServeHTTP(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		accept   string
+		expected int
+	}{
+		{
+			desc:     "browser gets the loading page",
+			accept:   "text/html",
+			expected: http.StatusAccepted,
+		},
+		{
+			desc:     "api client blocks",
+			accept:   "application/json",
+			expected: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, "starting")
+			}))
+			defer mockServer.Close()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+			group := GroupPoller{Services: []Service{{Name: "whoami", Request: mockServer.URL}}}
+
+			hybridStrategy := &HybridStrategy{
+				Dynamic:  &DynamicStrategy{Name: "whoami", Group: group, Next: next},
+				Blocking: &BlockingStrategy{Name: "whoami", Group: group, Next: next, BlockDelay: 100 * time.Millisecond},
+				Rules:    defaultHybridRules,
+			}
+
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "http://mydomain/whoami", nil)
+			req.Header.Set("Accept", test.accept)
+
+			hybridStrategy.ServeHTTP(recorder, req)
+
+			assert.Equal(t, test.expected, recorder.Code)
+		})
+	}
+}
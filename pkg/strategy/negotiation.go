@@ -0,0 +1,118 @@
+package strategy
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/pages"
+)
+
+// acceptRange is a media range of an Accept header
+type acceptRange struct {
+	mediaType string
+	quality   float64
+}
+
+// parseAccept returns the media ranges of an Accept header, ignoring the malformed ones
+func parseAccept(header string) []acceptRange {
+	var ranges []acceptRange
+	for _, part := range strings.Split(header, ",") {
+		if len(strings.TrimSpace(part)) == 0 {
+			continue
+		}
+
+		mediaType, params, err := mime.ParseMediaType(part)
+		if err != nil {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+
+		ranges = append(ranges, acceptRange{mediaType: mediaType, quality: quality})
+	}
+	return ranges
+}
+
+// acceptsExplicitly reports whether the Accept header names one of the media types, wildcards do not count
+func acceptsExplicitly(header string, mediaTypes []string) bool {
+	for _, accepted := range parseAccept(header) {
+		if accepted.quality <= 0 {
+			continue
+		}
+		for _, mediaType := range mediaTypes {
+			if strings.EqualFold(accepted.mediaType, mediaType) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// negotiate returns the offer preferred by the Accept header, ties are won by the first offer.
+// The first offer is returned when the header is empty or accepts none of the offers.
+func negotiate(header string, offers []string) string {
+	ranges := parseAccept(header)
+
+	best := offers[0]
+	bestQuality := 0.0
+	for _, offer := range offers {
+		if quality := offerQuality(ranges, offer); quality > bestQuality {
+			best = offer
+			bestQuality = quality
+		}
+	}
+	return best
+}
+
+// offerQuality returns the quality of the most specific media range matching the offer
+func offerQuality(ranges []acceptRange, offer string) float64 {
+	offerType, offerSubtype := splitMediaType(offer)
+	quality := 0.0
+	specificity := -1
+
+	for _, accepted := range ranges {
+		acceptedType, acceptedSubtype := splitMediaType(accepted.mediaType)
+
+		var rangeSpecificity int
+		switch {
+		case acceptedType == offerType && acceptedSubtype == offerSubtype:
+			rangeSpecificity = 2
+		case acceptedType == offerType && acceptedSubtype == "*":
+			rangeSpecificity = 1
+		case acceptedType == "*" && acceptedSubtype == "*":
+			rangeSpecificity = 0
+		default:
+			continue
+		}
+
+		if rangeSpecificity > specificity {
+			specificity = rangeSpecificity
+			quality = accepted.quality
+		}
+	}
+	return quality
+}
+
+// negotiateLocale returns the language of the pages served to the request, fallback when the client accepts none of the
+// translated ones
+func negotiateLocale(req *http.Request, fallback string) string {
+	if len(fallback) == 0 {
+		fallback = pages.DefaultLocale
+	}
+	return pages.NegotiateLocale(req.Header.Get("Accept-Language"), fallback)
+}
+
+func splitMediaType(mediaType string) (string, string) {
+	parts := strings.SplitN(strings.ToLower(mediaType), "/", 2)
+	if len(parts) != 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
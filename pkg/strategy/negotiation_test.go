@@ -0,0 +1,35 @@
+package strategy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiate(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		accept   string
+		offers   []string
+		expected string
+	}{
+		{desc: "no accept header", accept: "", offers: HTMLFirst, expected: mediaTypeHTML},
+		{desc: "wildcard keeps preference", accept: "*/*", offers: JSONFirst, expected: mediaTypeProblemJSON},
+		{desc: "browser", accept: "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8", offers: JSONFirst, expected: mediaTypeHTML},
+		{desc: "json client", accept: "application/json", offers: HTMLFirst, expected: mediaTypeJSON},
+		{desc: "problem client", accept: "application/problem+json", offers: HTMLFirst, expected: mediaTypeProblemJSON},
+		{desc: "type wildcard", accept: "text/*", offers: JSONFirst, expected: mediaTypeHTML},
+		{desc: "quality wins over order", accept: "text/html;q=0.5, text/plain", offers: HTMLFirst, expected: mediaTypeText},
+		{desc: "refused by quality", accept: "text/html;q=0, */*", offers: HTMLFirst, expected: mediaTypeProblemJSON},
+		{desc: "nothing acceptable", accept: "image/png", offers: JSONFirst, expected: mediaTypeProblemJSON},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, negotiate(test.accept, test.offers))
+		})
+	}
+}
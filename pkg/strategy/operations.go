@@ -0,0 +1,124 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/client"
+)
+
+// Operation is a call to the ondemand service, the zero value means the operation is not used
+type Operation struct {
+	Method  string
+	Request string
+}
+
+// IsZero reports whether the operation is not used
+func (o Operation) IsZero() bool {
+	return len(o.Request) == 0
+}
+
+// Actions sends the wake and activity operations of the services to the ondemand service.
+// Services without such operations are woken up, and their activity recorded, by the status request itself.
+type Actions struct {
+	Client client.Doer
+	// Cooldown is the minimum delay between two wake operations of a service
+	Cooldown time.Duration
+
+	mu    sync.Mutex
+	woken map[string]time.Time
+
+	// now is replaced in tests
+	now func() time.Time
+}
+
+// NewActions creates the actions sent with client
+func NewActions(client client.Doer, cooldown time.Duration) *Actions {
+	return &Actions{
+		Client:   client,
+		Cooldown: cooldown,
+		woken:    make(map[string]time.Time),
+		now:      time.Now,
+	}
+}
+
+// Wake sends the wake operation of the service, unless it was already sent during the cooldown
+func (a *Actions) Wake(ctx context.Context, service Service) error {
+	if a == nil || service.Wake.IsZero() {
+		return nil
+	}
+
+	a.mu.Lock()
+	if wokenAt, ok := a.woken[service.Name]; ok && a.now().Sub(wokenAt) < a.Cooldown {
+		a.mu.Unlock()
+		return nil
+	}
+	a.woken[service.Name] = a.now()
+	a.mu.Unlock()
+
+	log.Printf("Waking up %s: %s %s", service.Name, service.Wake.Method, service.Wake.Request)
+	err := a.send(ctx, service.Wake)
+
+	if err != nil {
+		// Let the next request try again
+		a.mu.Lock()
+		delete(a.woken, service.Name)
+		a.mu.Unlock()
+	}
+	return err
+}
+
+// RecordActivity sends the activity operation of the service
+func (a *Actions) RecordActivity(ctx context.Context, service Service) error {
+	if a == nil || service.Activity.IsZero() {
+		return nil
+	}
+	return a.send(ctx, service.Activity)
+}
+
+func (a *Actions) send(ctx context.Context, operation Operation) error {
+	doer := a.Client
+	if doer == nil {
+		doer = client.Default
+	}
+
+	req, err := http.NewRequestWithContext(ctx, operation.Method, operation.Request, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		return &UnreachableError{Err: err}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s %s: %s %s", operation.Method, operation.Request, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	io.Copy(ioutil.Discard, resp.Body)
+	return nil
+}
+
+// ActivityHandler records the activity of the group for the requests forwarded to the services, when the rules allow it
+type ActivityHandler struct {
+	Group GroupPoller
+	Rules RequestRules
+	Next  http.Handler
+}
+
+func (h *ActivityHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if h.Rules.Allows(req) {
+		h.Group.RecordActivity()
+	}
+	h.Next.ServeHTTP(rw, req)
+}
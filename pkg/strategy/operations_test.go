@@ -0,0 +1,144 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupPoller_Wake(t *testing.T) {
+	var state atomic.Value
+	state.Store("stopped")
+	var wakes int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/status":
+			assert.Equal(t, http.MethodGet, r.Method)
+			fmt.Fprint(w, state.Load())
+		case "/wake":
+			assert.Equal(t, http.MethodPost, r.Method)
+			atomic.AddInt32(&wakes, 1)
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	now := time.Now()
+	actions := NewActions(nil, 5*time.Second)
+	actions.now = func() time.Time { return now }
+
+	services := []Service{{
+		Name:    "whoami",
+		Request: server.URL + "/status",
+		Wake:    Operation{Method: http.MethodPost, Request: server.URL + "/wake"},
+	}}
+
+	observer := &GroupPoller{Services: services, Actions: actions}
+	waking := &GroupPoller{Services: services, Actions: actions, Wake: true}
+
+	// Observing the group does not wake it up
+	group := observer.Poll(context.Background())
+	assert.Equal(t, StateStopped, group.Results[0].State())
+	assert.Equal(t, int32(0), atomic.LoadInt32(&wakes))
+
+	group = waking.Poll(context.Background())
+	assert.Equal(t, StateStarting, group.Results[0].State())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&wakes))
+
+	// The service is not woken up again during the cooldown
+	waking.Poll(context.Background())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&wakes))
+
+	now = now.Add(5 * time.Second)
+	waking.Poll(context.Background())
+	assert.Equal(t, int32(2), atomic.LoadInt32(&wakes))
+
+	// Started services are not woken up
+	state.Store("started")
+	now = now.Add(5 * time.Second)
+	group = waking.Poll(context.Background())
+	assert.Equal(t, StateStarted, group.State())
+	assert.Equal(t, int32(2), atomic.LoadInt32(&wakes))
+}
+
+func TestGroupPoller_Wake_Failed(t *testing.T) {
+	var wakes int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/wake" {
+			atomic.AddInt32(&wakes, 1)
+			http.Error(w, "quota exceeded", http.StatusForbidden)
+			return
+		}
+		fmt.Fprint(w, "stopped")
+	}))
+	defer server.Close()
+
+	waking := &GroupPoller{
+		Services: []Service{{
+			Name:    "whoami",
+			Request: server.URL + "/status",
+			Wake:    Operation{Method: http.MethodPost, Request: server.URL + "/wake"},
+		}},
+		Actions: NewActions(nil, time.Minute),
+		Wake:    true,
+	}
+
+	group := waking.Poll(context.Background())
+	assert.Equal(t, StateFailed, group.State())
+	assert.Contains(t, group.Results[0].Err.Error(), "quota exceeded")
+
+	// A failed wake is not subject to the cooldown
+	waking.Poll(context.Background())
+	assert.Equal(t, int32(2), atomic.LoadInt32(&wakes))
+}
+
+func TestActivityHandler(t *testing.T) {
+	activities := make(chan string, 10)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		activities <- r.URL.Query().Get("name")
+	}))
+	defer server.Close()
+
+	deny, err := NewRequestMatcher([]string{"/healthz"}, nil, nil, nil, nil)
+	assert.NoError(t, err)
+
+	var forwarded int32
+	handler := &ActivityHandler{
+		Group: GroupPoller{
+			Services: []Service{{
+				Name:     "whoami",
+				Activity: Operation{Method: http.MethodPut, Request: server.URL + "/activity?name=whoami"},
+			}},
+			Activity: NewActivityBatcher(0, NewActions(nil, 0), nil),
+		},
+		Rules: RequestRules{Deny: deny},
+		Next: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&forwarded, 1)
+		}),
+	}
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	select {
+	case name := <-activities:
+		assert.Equal(t, "whoami", name)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the activity was not recorded")
+	}
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&forwarded))
+	assert.Len(t, activities, 0)
+}
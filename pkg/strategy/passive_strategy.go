@@ -0,0 +1,33 @@
+package strategy
+
+import (
+	"net/http"
+)
+
+// SleepingResponse is served to the requests which must not wake the services while they are not started
+type SleepingResponse struct {
+	Status      int
+	ContentType string
+	Body        string
+}
+
+// PassiveStrategy serves the requests which must not wake the services up: they are forwarded when the group
+// was recently known started, and get the sleeping response otherwise. The ondemand service is never queried.
+type PassiveStrategy struct {
+	Group    GroupPoller
+	Next     http.Handler
+	Response SleepingResponse
+}
+
+// ServeHTTP forward the request or serve the sleeping response
+func (e *PassiveStrategy) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if group, ok := e.Group.Peek(); ok && group.State() == StateStarted {
+		e.Next.ServeHTTP(rw, req)
+		return
+	}
+
+	rw.Header().Set("Content-Type", e.Response.ContentType)
+	rw.Header().Set("Cache-Control", "no-store")
+	rw.WriteHeader(e.Response.Status)
+	rw.Write([]byte(e.Response.Body))
+}
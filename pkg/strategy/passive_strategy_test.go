@@ -0,0 +1,81 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPassiveStrategy_ServeHTTP(t *testing.T) {
+	var calls int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		fmt.Fprint(w, "started")
+	}))
+	defer mockServer.Close()
+
+	nextCalled := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nextCalled = true
+	})
+
+	group := GroupPoller{
+		Services: []Service{{Name: "whoami", Request: mockServer.URL}},
+		Cache:    NewStatusCache(0, 0),
+	}
+	passive := &PassiveStrategy{
+		Group:    group,
+		Next:     next,
+		Response: SleepingResponse{Status: http.StatusNotFound, ContentType: "text/plain", Body: "asleep"},
+	}
+
+	// Unknown status, the ondemand service is not queried
+	recorder := httptest.NewRecorder()
+	passive.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://mydomain/robots.txt", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Equal(t, "asleep", recorder.Body.String())
+	assert.False(t, nextCalled)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+
+	// Known started from a previous waking request, even expired
+	group.Poll(context.Background())
+	recorder = httptest.NewRecorder()
+	passive.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://mydomain/robots.txt", nil))
+	assert.True(t, nextCalled)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestPassiveStrategy_ServeHTTP_Stale(t *testing.T) {
+	cache := NewStatusCache(0, 0)
+	cache.fetch = func(ctx context.Context, name string, request string) (*ServiceStatus, error) {
+		// Started when last checked, longer ago than the service timeout
+		return &ServiceStatus{State: StateStarted, CheckedAt: time.Now().Add(-2 * time.Minute)}, nil
+	}
+
+	nextCalled := false
+	group := GroupPoller{
+		Services: []Service{{Name: "whoami", Request: "http://ondemand", Timeout: time.Minute}},
+		Cache:    cache,
+	}
+	passive := &PassiveStrategy{
+		Group:    group,
+		Next:     http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { nextCalled = true }),
+		Response: SleepingResponse{Status: http.StatusNotFound, ContentType: "text/plain", Body: "asleep"},
+	}
+
+	group.Poll(context.Background())
+	assert.NotNil(t, cache.Peek("whoami"))
+
+	// The service may have been stopped for inactivity since, the request is not forwarded
+	recorder := httptest.NewRecorder()
+	passive.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://mydomain/robots.txt", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Equal(t, "asleep", recorder.Body.String())
+	assert.False(t, nextCalled)
+}
@@ -0,0 +1,195 @@
+package strategy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/client"
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/pages"
+)
+
+// Stable identifiers of the problems reported by the strategies
+const (
+	ProblemWakeTimeout   = "urn:traefik-ondemand-plugin:problem:wake-timeout"
+	ProblemUnreachable   = "urn:traefik-ondemand-plugin:problem:ondemand-unreachable"
+	ProblemServiceFailed = "urn:traefik-ondemand-plugin:problem:service-failed"
+	ProblemCircuitOpen   = "urn:traefik-ondemand-plugin:problem:circuit-open"
+)
+
+const (
+	mediaTypeHTML        = "text/html"
+	mediaTypeProblemJSON = "application/problem+json"
+	mediaTypeJSON        = "application/json"
+	mediaTypeText        = "text/plain"
+)
+
+// Problem is an RFC 7807 problem details document
+type Problem struct {
+	Type       string           `json:"type"`
+	Title      string           `json:"title"`
+	Status     int              `json:"status"`
+	Detail     string           `json:"detail,omitempty"`
+	Instance   string           `json:"instance,omitempty"`
+	Middleware string           `json:"middleware,omitempty"`
+	Services   []ServiceProblem `json:"services,omitempty"`
+}
+
+// ServiceProblem is the failure of a single service of the group
+type ServiceProblem struct {
+	Name  string `json:"name"`
+	Error string `json:"error"`
+}
+
+// NewWakeTimeoutProblem reports the services of the group still not started after delay
+func NewWakeTimeoutProblem(delay time.Duration, group GroupStatus) Problem {
+	problem := Problem{
+		Type:   ProblemWakeTimeout,
+		Title:  "Wake timeout",
+		Status: http.StatusServiceUnavailable,
+		Detail: fmt.Sprintf("Service was unreachable within %s", delay),
+	}
+
+	for _, result := range group.Results {
+		var message string
+		switch result.State() {
+		case StateWaiting:
+			message = "service is waiting for " + strings.Join(result.Service.DependsOn, ", ")
+		case StateStarting:
+			message = "service is still " + result.Status.State
+			if progress := result.Status.Progress(); len(progress) != 0 {
+				message = fmt.Sprintf("%s (%s replicas ready)", message, progress)
+			}
+		default:
+			continue
+		}
+		problem.Services = append(problem.Services, ServiceProblem{Name: result.Service.Name, Error: message})
+	}
+
+	return problem
+}
+
+// NewGroupProblem reports the failures of the group services, typed after the most severe one whatever the order
+// of the services: a failed service before an unreachable ondemand service before an open circuit
+func NewGroupProblem(group GroupStatus) Problem {
+	failures := group.Failures()
+
+	problem := Problem{
+		Type:   ProblemServiceFailed,
+		Title:  "Service failed",
+		Status: http.StatusInternalServerError,
+	}
+
+	if len(failures) == 0 {
+		return problem
+	}
+
+	worst := failures[0]
+	for _, failure := range failures[1:] {
+		if failureSeverity(failure.Err) > failureSeverity(worst.Err) {
+			worst = failure
+		}
+	}
+
+	var unreachable *UnreachableError
+	switch {
+	case errors.Is(worst.Err, client.ErrCircuitOpen):
+		problem.Type = ProblemCircuitOpen
+		problem.Title = "Ondemand service unavailable"
+		problem.Status = http.StatusServiceUnavailable
+	case errors.As(worst.Err, &unreachable):
+		problem.Type = ProblemUnreachable
+		problem.Title = "Ondemand service unreachable"
+	}
+
+	for _, failure := range failures {
+		problem.Services = append(problem.Services, ServiceProblem{Name: failure.Service.Name, Error: failure.Err.Error()})
+	}
+	problem.Detail = worst.Err.Error()
+
+	return problem
+}
+
+// failureSeverity ranks the failures, a failed service does not recover by retrying unlike the ondemand service
+func failureSeverity(err error) int {
+	var unreachable *UnreachableError
+	switch {
+	case errors.Is(err, client.ErrCircuitOpen):
+		return 0
+	case errors.As(err, &unreachable):
+		return 1
+	default:
+		return 2
+	}
+}
+
+func (p Problem) servicesData() []pages.ServiceErrorData {
+	services := make([]pages.ServiceErrorData, 0, len(p.Services))
+	for _, service := range p.Services {
+		services = append(services, pages.ServiceErrorData{Name: service.Name, Error: service.Error})
+	}
+	return services
+}
+
+// ErrorRenderer writes the problems as an HTML page, a problem+json document or plain text according to the Accept header
+type ErrorRenderer struct {
+	// Name is the name of the middleware
+	Name string
+	// ErrorPage is the HTML error page template, the built-in page is used when nil
+	ErrorPage *pages.Template
+	// Offers are the media types in order of preference when the client accepts several of them
+	Offers []string
+	// Locale is the language of the error page when the client accepts none of the translated ones
+	Locale string
+	// AssetsUrl is the URL of the assets of the error page, the assets endpoint at the root of the router when empty
+	AssetsUrl string
+}
+
+// HTMLFirst prefers the HTML error page, for browsers
+var HTMLFirst = []string{mediaTypeHTML, mediaTypeProblemJSON, mediaTypeJSON, mediaTypeText}
+
+// JSONFirst prefers the problem+json document, for API clients
+var JSONFirst = []string{mediaTypeProblemJSON, mediaTypeJSON, mediaTypeHTML, mediaTypeText}
+
+// Render writes the problem
+func (r *ErrorRenderer) Render(rw http.ResponseWriter, req *http.Request, problem Problem) {
+	offers := r.Offers
+	if len(offers) == 0 {
+		offers = HTMLFirst
+	}
+
+	problem.Instance = req.URL.RequestURI()
+	problem.Middleware = r.Name
+
+	mediaType := negotiate(req.Header.Get("Accept"), offers)
+
+	switch mediaType {
+	case mediaTypeHTML:
+		rw.Header().Set("Content-Type", "text/html; charset=utf-8")
+		rw.WriteHeader(problem.Status)
+		rw.Write([]byte(pages.GetErrorPage(r.ErrorPage, pages.ErrorData{
+			Locale:     negotiateLocale(req, r.Locale),
+			Name:       r.Name,
+			Middleware: r.Name,
+			Status:     problem.Status,
+			Title:      problem.Title,
+			Type:       problem.Type,
+			Error:      problem.Detail,
+			Services:   problem.servicesData(),
+			Request:    toRequestData(req),
+			RetryUrl:   problem.Instance,
+			AssetsUrl:  AssetsURL(req, r.AssetsUrl),
+		})))
+	case mediaTypeProblemJSON, mediaTypeJSON:
+		rw.Header().Set("Content-Type", mediaType)
+		rw.WriteHeader(problem.Status)
+		json.NewEncoder(rw).Encode(problem)
+	default:
+		rw.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		rw.WriteHeader(problem.Status)
+		fmt.Fprintf(rw, "%s: %s\n", problem.Title, problem.Detail)
+	}
+}
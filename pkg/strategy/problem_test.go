@@ -0,0 +1,203 @@
+package strategy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorRenderer_Render(t *testing.T) {
+	testCases := []struct {
+		desc                string
+		accept              string
+		offers              []string
+		expectedContentType string
+		expectedBody        string
+	}{
+		{
+			desc:                "browser gets the error page",
+			accept:              "text/html",
+			offers:              JSONFirst,
+			expectedContentType: "text/html; charset=utf-8",
+			expectedBody:        `<a href="/whoami?page=1">`,
+		},
+		{
+			desc:                "api client gets problem json",
+			accept:              "application/json, application/problem+json",
+			offers:              HTMLFirst,
+			expectedContentType: "application/problem+json",
+			expectedBody:        `"type":"` + ProblemServiceFailed + `"`,
+		},
+		{
+			desc:                "json client gets json",
+			accept:              "application/json",
+			offers:              HTMLFirst,
+			expectedContentType: "application/json",
+			expectedBody:        `"title":"Service failed"`,
+		},
+		{
+			desc:                "plain text client",
+			accept:              "text/plain",
+			offers:              HTMLFirst,
+			expectedContentType: "text/plain; charset=utf-8",
+			expectedBody:        "Service failed: image not found\n",
+		},
+		{
+			desc:                "default of html first",
+			offers:              HTMLFirst,
+			expectedContentType: "text/html; charset=utf-8",
+			expectedBody:        "image not found",
+		},
+		{
+			desc:                "default of json first",
+			accept:              "*/*",
+			offers:              JSONFirst,
+			expectedContentType: "application/problem+json",
+			expectedBody:        `"detail":"image not found"`,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			renderer := &ErrorRenderer{Name: "whoami", Offers: test.offers}
+			group := GroupStatus{Results: []ServiceResult{
+				{Service: Service{Name: "whoami"}, Status: &ServiceStatus{State: StateFailed, Message: "image not found"}, Err: errors.New("image not found")},
+			}}
+
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "http://mydomain/whoami?page=1", nil)
+			if len(test.accept) != 0 {
+				req.Header.Set("Accept", test.accept)
+			}
+
+			renderer.Render(recorder, req, NewGroupProblem(group))
+
+			assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+			assert.Equal(t, test.expectedContentType, recorder.Header().Get("Content-Type"))
+			assert.Contains(t, recorder.Body.String(), test.expectedBody)
+		})
+	}
+}
+
+func TestNewGroupProblem(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		results        []ServiceResult
+		expectedType   string
+		expectedTitle  string
+		expectedStatus int
+		expectedDetail string
+	}{
+		{
+			desc: "service failed",
+			results: []ServiceResult{
+				{Service: Service{Name: "whoami"}, Err: errors.New("image not found")},
+			},
+			expectedType:   ProblemServiceFailed,
+			expectedTitle:  "Service failed",
+			expectedStatus: http.StatusInternalServerError,
+			expectedDetail: "image not found",
+		},
+		{
+			desc: "ondemand service unreachable",
+			results: []ServiceResult{
+				{Service: Service{Name: "whoami"}, Err: &UnreachableError{Err: errors.New("connection refused")}},
+			},
+			expectedType:   ProblemUnreachable,
+			expectedTitle:  "Ondemand service unreachable",
+			expectedStatus: http.StatusInternalServerError,
+			expectedDetail: "connection refused",
+		},
+		{
+			desc: "circuit open",
+			results: []ServiceResult{
+				{Service: Service{Name: "whoami"}, Err: fmt.Errorf("%w for http://ondemand:10000", client.ErrCircuitOpen)},
+			},
+			expectedType:   ProblemCircuitOpen,
+			expectedTitle:  "Ondemand service unavailable",
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedDetail: "circuit breaker is open for http://ondemand:10000",
+		},
+		{
+			desc: "service failed after an unreachable ondemand service",
+			results: []ServiceResult{
+				{Service: Service{Name: "whoami-1"}, Err: &UnreachableError{Err: errors.New("connection refused")}},
+				{Service: Service{Name: "whoami-2"}, Err: errors.New("image not found")},
+			},
+			expectedType:   ProblemServiceFailed,
+			expectedTitle:  "Service failed",
+			expectedStatus: http.StatusInternalServerError,
+			expectedDetail: "image not found",
+		},
+		{
+			desc: "ondemand service unreachable after an open circuit",
+			results: []ServiceResult{
+				{Service: Service{Name: "whoami-1"}, Err: fmt.Errorf("%w for http://ondemand:10000", client.ErrCircuitOpen)},
+				{Service: Service{Name: "whoami-2"}, Status: &ServiceStatus{State: StateStarted}},
+				{Service: Service{Name: "whoami-3"}, Err: &UnreachableError{Err: errors.New("connection refused")}},
+			},
+			expectedType:   ProblemUnreachable,
+			expectedTitle:  "Ondemand service unreachable",
+			expectedStatus: http.StatusInternalServerError,
+			expectedDetail: "connection refused",
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			problem := NewGroupProblem(GroupStatus{Results: test.results})
+
+			assert.Equal(t, test.expectedType, problem.Type)
+			assert.Equal(t, test.expectedTitle, problem.Title)
+			assert.Equal(t, test.expectedStatus, problem.Status)
+			assert.Equal(t, test.expectedDetail, problem.Detail)
+			failures := GroupStatus{Results: test.results}.Failures()
+			require.Len(t, problem.Services, len(failures))
+			for index, failure := range failures {
+				assert.Equal(t, failure.Service.Name, problem.Services[index].Name)
+			}
+		})
+	}
+}
+
+func TestNewWakeTimeoutProblem(t *testing.T) {
+	group := GroupStatus{Results: []ServiceResult{
+		{Service: Service{Name: "whoami-1"}, Status: &ServiceStatus{State: StateStarted}},
+		{Service: Service{Name: "whoami-2"}, Status: &ServiceStatus{State: StateStarting, ReadyReplicas: 1, DesiredReplicas: 3}},
+	}}
+
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "http://mydomain/whoami", nil)
+	req.Header.Set("Accept", "application/problem+json")
+
+	renderer := &ErrorRenderer{Name: "whoami", Offers: JSONFirst}
+	renderer.Render(recorder, req, NewWakeTimeoutProblem(time.Minute, group))
+
+	var problem Problem
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.Equal(t, Problem{
+		Type:       ProblemWakeTimeout,
+		Title:      "Wake timeout",
+		Status:     http.StatusServiceUnavailable,
+		Detail:     "Service was unreachable within 1m0s",
+		Instance:   "/whoami",
+		Middleware: "whoami",
+		Services:   []ServiceProblem{{Name: "whoami-2", Error: "service is still starting (1/3 replicas ready)"}},
+	}, problem)
+}
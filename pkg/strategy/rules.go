@@ -0,0 +1,128 @@
+package strategy
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// RequestMatcher matches a request when any of its criteria matches
+type RequestMatcher struct {
+	// Paths are globs of the request path, e.g. /.well-known/*
+	Paths []string
+	// Methods of the request, e.g. HEAD
+	Methods []string
+	// UserAgents are regular expressions of the User-Agent header, e.g. (?i)bot
+	UserAgents []*regexp.Regexp
+	// Headers which presence matches the request, e.g. X-Health-Check
+	Headers []string
+	// SourceRanges of the client address
+	SourceRanges []*net.IPNet
+}
+
+// NewRequestMatcher validates the patterns, ranges can be IP addresses or CIDRs
+func NewRequestMatcher(paths []string, methods []string, userAgents []string, headers []string, sourceRanges []string) (RequestMatcher, error) {
+	matcher := RequestMatcher{Paths: paths, Methods: methods, Headers: headers}
+
+	for _, pattern := range paths {
+		if _, err := path.Match(pattern, "/"); err != nil {
+			return RequestMatcher{}, fmt.Errorf("invalid path glob %q: %w", pattern, err)
+		}
+	}
+
+	for _, pattern := range userAgents {
+		userAgent, err := regexp.Compile(pattern)
+		if err != nil {
+			return RequestMatcher{}, fmt.Errorf("invalid user agent pattern %q: %w", pattern, err)
+		}
+		matcher.UserAgents = append(matcher.UserAgents, userAgent)
+	}
+
+	for _, sourceRange := range sourceRanges {
+		if !strings.Contains(sourceRange, "/") {
+			if ip := net.ParseIP(sourceRange); ip != nil && ip.To4() != nil {
+				sourceRange += "/32"
+			} else {
+				sourceRange += "/128"
+			}
+		}
+		_, network, err := net.ParseCIDR(sourceRange)
+		if err != nil {
+			return RequestMatcher{}, fmt.Errorf("invalid source range %q: %w", sourceRange, err)
+		}
+		matcher.SourceRanges = append(matcher.SourceRanges, network)
+	}
+
+	return matcher, nil
+}
+
+// IsEmpty reports whether the matcher has no criteria
+func (m RequestMatcher) IsEmpty() bool {
+	return len(m.Paths) == 0 && len(m.Methods) == 0 && len(m.UserAgents) == 0 && len(m.Headers) == 0 && len(m.SourceRanges) == 0
+}
+
+// Matches reports whether any criteria matches the request
+func (m RequestMatcher) Matches(req *http.Request) bool {
+	for _, pattern := range m.Paths {
+		if matched, _ := path.Match(pattern, req.URL.Path); matched {
+			return true
+		}
+	}
+
+	if containsFold(m.Methods, req.Method) {
+		return true
+	}
+
+	if userAgent := req.UserAgent(); len(userAgent) != 0 {
+		for _, pattern := range m.UserAgents {
+			if pattern.MatchString(userAgent) {
+				return true
+			}
+		}
+	}
+
+	for _, header := range m.Headers {
+		if len(req.Header.Values(header)) != 0 {
+			return true
+		}
+	}
+
+	if len(m.SourceRanges) != 0 {
+		if ip := sourceIP(req); ip != nil {
+			for _, network := range m.SourceRanges {
+				if network.Contains(ip) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func sourceIP(req *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		host = req.RemoteAddr
+	}
+	return net.ParseIP(host)
+}
+
+// RequestRules select requests, e.g. the requests allowed to wake the services up
+type RequestRules struct {
+	// Allow restricts the selected requests, every request when empty
+	Allow RequestMatcher
+	// Deny are the requests never selected, it takes precedence over Allow
+	Deny RequestMatcher
+}
+
+// Allows reports whether the request is selected by the rules
+func (r RequestRules) Allows(req *http.Request) bool {
+	if r.Deny.Matches(req) {
+		return false
+	}
+	return r.Allow.IsEmpty() || r.Allow.Matches(req)
+}
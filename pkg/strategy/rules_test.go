@@ -0,0 +1,163 @@
+package strategy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestRules_Allows(t *testing.T) {
+	deny, err := NewRequestMatcher(
+		[]string{"/favicon.ico", "/.well-known/*"},
+		[]string{"HEAD"},
+		[]string{"(?i)bot", "UptimeRobot"},
+		[]string{"X-Health-Check"},
+		[]string{"10.0.0.0/8", "192.168.1.10"},
+	)
+	require.NoError(t, err)
+
+	allowOffice, err := NewRequestMatcher(nil, nil, nil, nil, []string{"203.0.113.0/24"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc       string
+		rules      RequestRules
+		method     string
+		path       string
+		headers    map[string]string
+		remoteAddr string
+		expected   bool
+	}{
+		{
+			desc:     "no rules",
+			rules:    RequestRules{},
+			method:   http.MethodGet,
+			path:     "/favicon.ico",
+			expected: true,
+		},
+		{
+			desc:     "browser",
+			rules:    RequestRules{Deny: deny},
+			method:   http.MethodGet,
+			path:     "/",
+			headers:  map[string]string{"User-Agent": "Mozilla/5.0 (X11; Linux x86_64) Firefox/89.0"},
+			expected: true,
+		},
+		{
+			desc:     "favicon",
+			rules:    RequestRules{Deny: deny},
+			method:   http.MethodGet,
+			path:     "/favicon.ico",
+			expected: false,
+		},
+		{
+			desc:     "path glob",
+			rules:    RequestRules{Deny: deny},
+			method:   http.MethodGet,
+			path:     "/.well-known/security.txt",
+			expected: false,
+		},
+		{
+			desc:     "method",
+			rules:    RequestRules{Deny: deny},
+			method:   http.MethodHead,
+			path:     "/",
+			expected: false,
+		},
+		{
+			desc:     "crawler",
+			rules:    RequestRules{Deny: deny},
+			method:   http.MethodGet,
+			path:     "/",
+			headers:  map[string]string{"User-Agent": "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)"},
+			expected: false,
+		},
+		{
+			desc:     "uptime monitor",
+			rules:    RequestRules{Deny: deny},
+			method:   http.MethodGet,
+			path:     "/",
+			headers:  map[string]string{"User-Agent": "Mozilla/5.0+(compatible; UptimeRobot/2.0)"},
+			expected: false,
+		},
+		{
+			desc:     "header presence",
+			rules:    RequestRules{Deny: deny},
+			method:   http.MethodGet,
+			path:     "/",
+			headers:  map[string]string{"X-Health-Check": ""},
+			expected: false,
+		},
+		{
+			desc:       "source range",
+			rules:      RequestRules{Deny: deny},
+			method:     http.MethodGet,
+			path:       "/",
+			remoteAddr: "10.1.2.3:41234",
+			expected:   false,
+		},
+		{
+			desc:       "source address",
+			rules:      RequestRules{Deny: deny},
+			method:     http.MethodGet,
+			path:       "/",
+			remoteAddr: "192.168.1.10:41234",
+			expected:   false,
+		},
+		{
+			desc:       "allowed source",
+			rules:      RequestRules{Allow: allowOffice, Deny: deny},
+			method:     http.MethodGet,
+			path:       "/",
+			remoteAddr: "203.0.113.7:41234",
+			expected:   true,
+		},
+		{
+			desc:       "source not allowed",
+			rules:      RequestRules{Allow: allowOffice, Deny: deny},
+			method:     http.MethodGet,
+			path:       "/",
+			remoteAddr: "198.51.100.7:41234",
+			expected:   false,
+		},
+		{
+			desc:       "deny takes precedence",
+			rules:      RequestRules{Allow: allowOffice, Deny: deny},
+			method:     http.MethodGet,
+			path:       "/favicon.ico",
+			remoteAddr: "203.0.113.7:41234",
+			expected:   false,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(test.method, "http://mydomain"+test.path, nil)
+			for key, value := range test.headers {
+				req.Header.Set(key, value)
+			}
+			if len(test.remoteAddr) != 0 {
+				req.RemoteAddr = test.remoteAddr
+			}
+
+			assert.Equal(t, test.expected, test.rules.Allows(req))
+		})
+	}
+}
+
+func TestNewRequestMatcher_Invalid(t *testing.T) {
+	_, err := NewRequestMatcher([]string{"/[a-"}, nil, nil, nil, nil)
+	assert.Error(t, err)
+
+	_, err = NewRequestMatcher(nil, nil, []string{"(bot"}, nil, nil)
+	assert.Error(t, err)
+
+	_, err = NewRequestMatcher(nil, nil, nil, nil, []string{"10.0.0.0/33"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,112 @@
+package strategy
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/schedule"
+)
+
+// Scheduler wakes the group up and keeps it awake during the awake windows of the schedule,
+// and tells when waking it up is forbidden during the closed windows
+type Scheduler struct {
+	Schedule schedule.Schedule
+	// Group wakes the services up
+	Group GroupPoller
+	// Interval is the delay between two checks of the group during the awake windows
+	Interval time.Duration
+
+	// now is replaced in tests
+	now func() time.Time
+}
+
+// NewScheduler creates a scheduler checking the group every interval
+func NewScheduler(schedule schedule.Schedule, group GroupPoller, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		Schedule: schedule,
+		Group:    group,
+		Interval: interval,
+		now:      time.Now,
+	}
+}
+
+// running are the schedulers started in the background, by middleware name. Traefik does not cancel the context
+// of a middleware when a configuration reload replaces it, the scheduler of the new instance stops the previous one.
+var (
+	runningMu sync.Mutex
+	running   = map[string]*run{}
+)
+
+type run struct {
+	cancel context.CancelFunc
+}
+
+// Start runs the scheduler in the background until ctx is done or another scheduler is started with the same name,
+// it stops the scheduler previously started with name. A nil scheduler only stops the previous one.
+func (s *Scheduler) Start(ctx context.Context, name string) {
+	runningMu.Lock()
+	defer runningMu.Unlock()
+
+	if previous, ok := running[name]; ok {
+		previous.cancel()
+		delete(running, name)
+	}
+
+	if s == nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	current := &run{cancel: cancel}
+	running[name] = current
+
+	go func() {
+		s.Run(ctx)
+
+		runningMu.Lock()
+		defer runningMu.Unlock()
+		if running[name] == current {
+			delete(running, name)
+		}
+		cancel()
+	}()
+}
+
+// Run keeps the group awake during the awake windows until ctx is done
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// tick wakes the stopped services up and records the activity of the group, so that it is not stopped for inactivity
+func (s *Scheduler) tick(ctx context.Context) {
+	if !s.Schedule.IsAwake(s.now()) {
+		return
+	}
+
+	group := s.Group.Poll(ctx)
+	if state := group.State(); state != StateStarted {
+		log.Printf("Scheduled wake up: %s", state)
+	}
+	s.Group.RecordActivity()
+}
+
+// ClosedUntil returns when the group can be woken up again, false when it can be woken up now
+func (s *Scheduler) ClosedUntil() (time.Time, bool) {
+	if s == nil {
+		return time.Time{}, false
+	}
+	return s.Schedule.ClosedUntil(s.now())
+}
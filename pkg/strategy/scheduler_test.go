@@ -0,0 +1,115 @@
+package strategy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/schedule"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler_tick(t *testing.T) {
+	var wakes, activities int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/wake":
+			atomic.AddInt32(&wakes, 1)
+		case "/activity":
+			atomic.AddInt32(&activities, 1)
+		default:
+			w.Write([]byte("stopped"))
+		}
+	}))
+	defer server.Close()
+
+	awake, err := schedule.ParseWindow("mon-fri", "08:00", "19:00")
+	require.NoError(t, err)
+
+	actions := NewActions(nil, 0)
+	group := GroupPoller{
+		Services: []Service{{
+			Name:     "whoami",
+			Request:  server.URL,
+			Wake:     Operation{Method: http.MethodPost, Request: server.URL + "/wake"},
+			Activity: Operation{Method: http.MethodPost, Request: server.URL + "/activity"},
+		}},
+		Actions:  actions,
+		Activity: NewActivityBatcher(0, actions, nil),
+		Wake:     true,
+	}
+
+	now := time.Date(2021, 6, 5, 10, 0, 0, 0, time.UTC)
+	scheduler := NewScheduler(schedule.Schedule{Location: time.UTC, Awake: []schedule.Window{awake}}, group, time.Minute)
+	scheduler.now = func() time.Time { return now }
+
+	// Saturday, the group is left asleep
+	scheduler.tick(context.Background())
+	assert.Equal(t, int32(0), atomic.LoadInt32(&wakes))
+
+	// Monday, the group is woken up and kept awake
+	now = time.Date(2021, 6, 7, 8, 0, 0, 0, time.UTC)
+	scheduler.tick(context.Background())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&wakes))
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&activities) == 1 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestScheduler_ClosedUntil(t *testing.T) {
+	closed, err := schedule.ParseWindow("*", "22:00", "06:00")
+	require.NoError(t, err)
+
+	now := time.Date(2021, 6, 1, 23, 0, 0, 0, time.UTC)
+	scheduler := NewScheduler(schedule.Schedule{Location: time.UTC, Closed: []schedule.Window{closed}}, GroupPoller{}, time.Minute)
+	scheduler.now = func() time.Time { return now }
+
+	until, ok := scheduler.ClosedUntil()
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2021, 6, 2, 6, 0, 0, 0, time.UTC), until)
+
+	now = time.Date(2021, 6, 2, 6, 0, 0, 0, time.UTC)
+	_, ok = scheduler.ClosedUntil()
+	assert.False(t, ok)
+
+	var none *Scheduler
+	_, ok = none.ClosedUntil()
+	assert.False(t, ok)
+}
+
+func TestScheduler_Start(t *testing.T) {
+	closed, err := schedule.ParseWindow("*", "22:00", "06:00")
+	require.NoError(t, err)
+
+	// Without awake windows the ticks only read the time
+	newScheduler := func(ticks *int32) *Scheduler {
+		scheduler := NewScheduler(schedule.Schedule{Location: time.UTC, Closed: []schedule.Window{closed}}, GroupPoller{}, 10*time.Millisecond)
+		scheduler.now = func() time.Time {
+			atomic.AddInt32(ticks, 1)
+			return time.Now()
+		}
+		return scheduler
+	}
+
+	var previousTicks, currentTicks int32
+	newScheduler(&previousTicks).Start(context.Background(), "TestScheduler_Start")
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&previousTicks) > 0 }, 5*time.Second, 10*time.Millisecond)
+
+	// A reload replaces the scheduler, the previous one stops ticking
+	newScheduler(&currentTicks).Start(context.Background(), "TestScheduler_Start")
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&currentTicks) > 0 }, 5*time.Second, 10*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	stopped := atomic.LoadInt32(&previousTicks)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, stopped, atomic.LoadInt32(&previousTicks))
+
+	// A reload removing the schedule stops the scheduler
+	var none *Scheduler
+	none.Start(context.Background(), "TestScheduler_Start")
+	time.Sleep(20 * time.Millisecond)
+	stopped = atomic.LoadInt32(&currentTicks)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, stopped, atomic.LoadInt32(&currentTicks))
+}
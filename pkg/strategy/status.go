@@ -0,0 +1,175 @@
+package strategy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+)
+
+// StatusMediaType is the media type of the versioned JSON status document
+const StatusMediaType = "application/vnd.ondemand.status+json"
+
+// StatusVersion is the status document version understood by the plugin
+const StatusVersion = 1
+
+// statusAccept asks for the JSON document first and falls back to the legacy plain text body
+var statusAccept = fmt.Sprintf("%s; version=%d, application/json; q=0.9, text/plain; q=0.5", StatusMediaType, StatusVersion)
+
+const (
+	StateStarted  = "started"
+	StateStarting = "starting"
+	StateFailed   = "failed"
+	// StateStopped is reported by a status operation which does not wake the service up
+	StateStopped = "stopped"
+	// StateWaiting is never reported by the ondemand service, the plugin has not woken the service up yet
+	StateWaiting = "waiting"
+	// StateUnknown is never reported by the ondemand service, the plugin has no recent status of the service
+	StateUnknown = "unknown"
+)
+
+// ServiceStatus is the state of a service as reported by the ondemand service
+type ServiceStatus struct {
+	Name                 string
+	State                string
+	ReadyReplicas        int
+	DesiredReplicas      int
+	LastTransitionTime   time.Time
+	Message              string
+	EstimatedTimeToReady time.Duration
+	// CheckedAt is the time the status was received from the ondemand service
+	CheckedAt time.Time
+}
+
+// statusDocument is the wire representation of ServiceStatus
+type statusDocument struct {
+	Version              int        `json:"version"`
+	Name                 string     `json:"name,omitempty"`
+	State                string     `json:"state"`
+	ReadyReplicas        int        `json:"readyReplicas"`
+	DesiredReplicas      int        `json:"desiredReplicas"`
+	LastTransitionTime   *time.Time `json:"lastTransitionTime,omitempty"`
+	Message              string     `json:"message,omitempty"`
+	EstimatedTimeToReady string     `json:"estimatedTimeToReady,omitempty"`
+}
+
+// MarshalJSON encodes the status with the same document format as the ondemand service
+func (s ServiceStatus) MarshalJSON() ([]byte, error) {
+	document := statusDocument{
+		Version:         StatusVersion,
+		Name:            s.Name,
+		State:           s.State,
+		ReadyReplicas:   s.ReadyReplicas,
+		DesiredReplicas: s.DesiredReplicas,
+		Message:         s.Message,
+	}
+	if !s.LastTransitionTime.IsZero() {
+		document.LastTransitionTime = &s.LastTransitionTime
+	}
+	if s.EstimatedTimeToReady > 0 {
+		document.EstimatedTimeToReady = s.EstimatedTimeToReady.String()
+	}
+	return json.Marshal(document)
+}
+
+// IsStarted reports whether the service is ready to receive requests
+func (s *ServiceStatus) IsStarted() bool {
+	return s.State == StateStarted
+}
+
+// IsStopped reports whether the service is asleep and must be woken up
+func (s *ServiceStatus) IsStopped() bool {
+	return s.State == StateStopped
+}
+
+// IsStarting reports whether the service is on its way to be ready
+func (s *ServiceStatus) IsStarting() bool {
+	return s.State == StateStarting
+}
+
+// Err returns the error described by the status, if any
+func (s *ServiceStatus) Err() error {
+	if s.IsStarted() || s.IsStarting() || s.IsStopped() {
+		return nil
+	}
+	if len(s.Message) != 0 {
+		return errors.New(s.Message)
+	}
+	return fmt.Errorf("service is in state %q", s.State)
+}
+
+// Progress describes the replicas progression, e.g. "1/3"
+func (s *ServiceStatus) Progress() string {
+	if s.DesiredReplicas == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", s.ReadyReplicas, s.DesiredReplicas)
+}
+
+// parseServiceStatus reads a status response body according to its content type
+func parseServiceStatus(contentType string, body []byte) (*ServiceStatus, error) {
+	if !isStatusDocument(contentType) {
+		return parseLegacyStatus(body), nil
+	}
+	return parseStatusDocument(body)
+}
+
+// parseStatusDocument reads the versioned JSON status document
+func parseStatusDocument(body []byte) (*ServiceStatus, error) {
+	var document statusDocument
+	if err := json.Unmarshal(body, &document); err != nil {
+		return nil, fmt.Errorf("invalid status document: %w", err)
+	}
+
+	if document.Version != StatusVersion {
+		return nil, fmt.Errorf("unsupported status document version %d", document.Version)
+	}
+
+	if len(document.State) == 0 {
+		return nil, errors.New("invalid status document: missing state")
+	}
+
+	status := &ServiceStatus{
+		Name:            document.Name,
+		State:           document.State,
+		ReadyReplicas:   document.ReadyReplicas,
+		DesiredReplicas: document.DesiredReplicas,
+		Message:         document.Message,
+	}
+
+	if document.LastTransitionTime != nil {
+		status.LastTransitionTime = *document.LastTransitionTime
+	}
+
+	if len(document.EstimatedTimeToReady) != 0 {
+		eta, err := time.ParseDuration(document.EstimatedTimeToReady)
+		if err != nil {
+			return nil, fmt.Errorf("invalid status document: %w", err)
+		}
+		status.EstimatedTimeToReady = eta
+	}
+
+	return status, nil
+}
+
+// parseLegacyStatus reads the plain text body sent by older ondemand services
+func parseLegacyStatus(body []byte) *ServiceStatus {
+	state := strings.TrimSpace(string(body))
+
+	if state == StateStarted || state == StateStarting || state == StateStopped {
+		return &ServiceStatus{State: state}
+	}
+
+	return &ServiceStatus{State: StateFailed, Message: state}
+}
+
+// isStatusDocument reports whether the content type announces a JSON status document
+func isStatusDocument(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || (strings.HasPrefix(mediaType, "application/") && strings.HasSuffix(mediaType, "+json"))
+}
@@ -0,0 +1,177 @@
+package strategy
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/client"
+)
+
+// DefaultStatusTimeout bounds a status call, its retries included, when the cache has no Timeout
+const DefaultStatusTimeout = 30 * time.Second
+
+// StatusCache shares the services status between concurrent requests.
+// Concurrent lookups for the same service are coalesced into a single call to the ondemand service
+// and the result is kept for StartingTTL or StartedTTL depending on the reported state.
+type StatusCache struct {
+	StartingTTL time.Duration
+	StartedTTL  time.Duration
+	// Client sends the requests to the ondemand service, the default client is used when nil
+	Client client.Doer
+	// Timeout bounds a status call, so that a hung ondemand service never blocks the requests sharing it,
+	// DefaultStatusTimeout when 0
+	Timeout time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*statusEntry
+
+	// fetch and now are replaced in tests
+	fetch func(ctx context.Context, name string, request string) (*ServiceStatus, error)
+	now   func() time.Time
+}
+
+type statusEntry struct {
+	status  *ServiceStatus
+	expires time.Time
+	call    *statusCall
+	// last is the last status received, kept after it expires
+	last *ServiceStatus
+}
+
+type statusCall struct {
+	done   chan struct{}
+	status *ServiceStatus
+	err    error
+}
+
+// NewStatusCache creates a cache keeping starting and started statuses for the given durations
+func NewStatusCache(startingTTL time.Duration, startedTTL time.Duration) *StatusCache {
+	cache := &StatusCache{
+		StartingTTL: startingTTL,
+		StartedTTL:  startedTTL,
+		entries:     make(map[string]*statusEntry),
+		now:         time.Now,
+	}
+	cache.fetch = func(ctx context.Context, name string, request string) (*ServiceStatus, error) {
+		return getServiceStatus(ctx, cache.Client, name, request)
+	}
+	return cache
+}
+
+// Get returns the status of the service, querying the ondemand service with request when it is not cached.
+// The call to the ondemand service outlives ctx so that other requests can still use its result.
+// A nil cache always queries the ondemand service.
+func (c *StatusCache) Get(ctx context.Context, name string, request string) (*ServiceStatus, error) {
+	if c == nil {
+		return getServiceStatus(ctx, nil, name, request)
+	}
+
+	c.mu.Lock()
+	entry := c.entry(name)
+
+	if entry.status != nil && c.now().Before(entry.expires) {
+		status := entry.status
+		c.mu.Unlock()
+		return status, nil
+	}
+
+	call := c.start(entry, name, request)
+	c.mu.Unlock()
+
+	return call.wait(ctx)
+}
+
+// Refresh queries the ondemand service even when the status is cached, and caches the new status.
+// It shares the call in progress for the service, if any.
+func (c *StatusCache) Refresh(ctx context.Context, name string, request string) (*ServiceStatus, error) {
+	if c == nil {
+		return getServiceStatus(ctx, nil, name, request)
+	}
+
+	c.mu.Lock()
+	call := c.start(c.entry(name), name, request)
+	c.mu.Unlock()
+
+	return call.wait(ctx)
+}
+
+// Peek returns the last status received for the service, even expired, without querying the ondemand service.
+// It returns nil when the service was never checked or when its last check failed.
+func (c *StatusCache) Peek(name string) *ServiceStatus {
+	if c == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry, ok := c.entries[name]; ok {
+		return entry.last
+	}
+	return nil
+}
+
+// entry returns the entry of the service, c.mu must be held
+func (c *StatusCache) entry(name string) *statusEntry {
+	entry, ok := c.entries[name]
+	if !ok {
+		entry = &statusEntry{}
+		c.entries[name] = entry
+	}
+	return entry
+}
+
+// start returns the call in progress for the entry, starting one if there is none, c.mu must be held
+func (c *StatusCache) start(entry *statusEntry, name string, request string) *statusCall {
+	if entry.call == nil {
+		entry.call = &statusCall{done: make(chan struct{})}
+		go c.run(entry, entry.call, name, request)
+	}
+	return entry.call
+}
+
+func (call *statusCall) wait(ctx context.Context) (*ServiceStatus, error) {
+	select {
+	case <-call.done:
+		return call.status, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *StatusCache) run(entry *statusEntry, call *statusCall, name string, request string) {
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = DefaultStatusTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	call.status, call.err = c.fetch(ctx, name, request)
+	cancel()
+
+	c.mu.Lock()
+	entry.call = nil
+	entry.status = nil
+	entry.last = call.status
+	if ttl := c.ttl(call.status, call.err); ttl > 0 {
+		entry.status = call.status
+		entry.expires = c.now().Add(ttl)
+	}
+	c.mu.Unlock()
+	close(call.done)
+}
+
+// ttl returns how long a result can be served from the cache, errors are never cached
+func (c *StatusCache) ttl(status *ServiceStatus, err error) time.Duration {
+	if err != nil || status == nil {
+		return 0
+	}
+	if status.IsStarted() {
+		return c.StartedTTL
+	}
+	if status.IsStarting() {
+		return c.StartingTTL
+	}
+	return 0
+}
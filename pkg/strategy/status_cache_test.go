@@ -0,0 +1,184 @@
+package strategy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusCache_CoalescesConcurrentLookups(t *testing.T) {
+	var calls int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(100 * time.Millisecond)
+		fmt.Fprint(w, "starting")
+	}))
+	defer mockServer.Close()
+
+	cache := NewStatusCache(time.Second, time.Second)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			status, err := cache.Get(context.Background(), "whoami", mockServer.URL)
+			assert.NoError(t, err)
+			assert.Equal(t, StateStarting, status.State)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestStatusCache_ConcurrentStrategyRequests(t *testing.T) {
+	var calls int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(100 * time.Millisecond)
+		fmt.Fprint(w, "starting")
+	}))
+	defer mockServer.Close()
+
+	dynamicStrategy := &DynamicStrategy{
+		Name: "whoami",
+		Group: GroupPoller{
+			Services: []Service{{Name: "whoami", Request: mockServer.URL}},
+			Cache:    NewStatusCache(time.Second, time.Second),
+		},
+		Next: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 500; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "http://mydomain/whoami", nil)
+			dynamicStrategy.ServeHTTP(recorder, req)
+			assert.Equal(t, http.StatusAccepted, recorder.Code)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestStatusCache_TTL(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		status        *ServiceStatus
+		err           error
+		elapsed       time.Duration
+		expectedCalls int
+	}{
+		{
+			desc:          "starting is served from cache",
+			status:        &ServiceStatus{State: StateStarting},
+			elapsed:       500 * time.Millisecond,
+			expectedCalls: 1,
+		},
+		{
+			desc:          "starting expires",
+			status:        &ServiceStatus{State: StateStarting},
+			elapsed:       time.Second,
+			expectedCalls: 2,
+		},
+		{
+			desc:          "started is served from cache",
+			status:        &ServiceStatus{State: StateStarted},
+			elapsed:       4 * time.Second,
+			expectedCalls: 1,
+		},
+		{
+			desc:          "started expires",
+			status:        &ServiceStatus{State: StateStarted},
+			elapsed:       5 * time.Second,
+			expectedCalls: 2,
+		},
+		{
+			desc:          "failed is not cached",
+			status:        &ServiceStatus{State: StateFailed},
+			expectedCalls: 2,
+		},
+		{
+			desc:          "errors are not cached",
+			err:           errors.New("connection refused"),
+			expectedCalls: 2,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Now()
+			calls := 0
+			cache := NewStatusCache(time.Second, 5*time.Second)
+			cache.now = func() time.Time { return now }
+			cache.fetch = func(ctx context.Context, name string, request string) (*ServiceStatus, error) {
+				calls++
+				return test.status, test.err
+			}
+
+			_, err := cache.Get(context.Background(), "whoami", "http://ondemand")
+			require.Equal(t, test.err, err)
+
+			now = now.Add(test.elapsed)
+
+			_, err = cache.Get(context.Background(), "whoami", "http://ondemand")
+			require.Equal(t, test.err, err)
+
+			assert.Equal(t, test.expectedCalls, calls)
+		})
+	}
+}
+
+func TestStatusCache_Timeout(t *testing.T) {
+	cache := NewStatusCache(time.Second, 5*time.Second)
+	cache.Timeout = 50 * time.Millisecond
+	cache.fetch = func(ctx context.Context, name string, request string) (*ServiceStatus, error) {
+		// The ondemand service never answers
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	// The shared call ends with the timeout, the following requests start a new one instead of waiting forever
+	for i := 0; i < 2; i++ {
+		_, err := cache.Get(context.Background(), "whoami", "http://ondemand")
+		assert.Equal(t, context.DeadlineExceeded, err)
+	}
+}
+
+func TestStatusCache_Refresh(t *testing.T) {
+	calls := 0
+	cache := NewStatusCache(time.Second, 5*time.Second)
+	cache.fetch = func(ctx context.Context, name string, request string) (*ServiceStatus, error) {
+		calls++
+		return &ServiceStatus{State: StateStarted}, nil
+	}
+
+	_, err := cache.Get(context.Background(), "whoami", "http://ondemand")
+	require.NoError(t, err)
+
+	// The cached status is ignored, and replaced
+	_, err = cache.Refresh(context.Background(), "whoami", "http://ondemand")
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	_, err = cache.Get(context.Background(), "whoami", "http://ondemand")
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
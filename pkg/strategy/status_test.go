@@ -0,0 +1,158 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetServiceStatus(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		contentType   string
+		status        int
+		body          string
+		expected      *ServiceStatus
+		expectedError bool
+		// expectedErrorText is checked when set
+		expectedErrorText string
+	}{
+		{
+			desc:        "legacy started",
+			contentType: "text/plain; charset=utf-8",
+			status:      200,
+			body:        "started\n",
+			expected:    &ServiceStatus{Name: "whoami", State: StateStarted},
+		},
+		{
+			desc:        "legacy starting",
+			contentType: "text/plain; charset=utf-8",
+			status:      200,
+			body:        "starting",
+			expected:    &ServiceStatus{Name: "whoami", State: StateStarting},
+		},
+		{
+			desc:        "legacy unknown state",
+			contentType: "text/plain; charset=utf-8",
+			status:      200,
+			body:        "exploded",
+			expected:    &ServiceStatus{Name: "whoami", State: StateFailed, Message: "exploded"},
+		},
+		{
+			desc:          "legacy error",
+			contentType:   "text/plain; charset=utf-8",
+			status:        503,
+			body:          "error",
+			expectedError: true,
+		},
+		{
+			desc:        "json starting",
+			contentType: StatusMediaType + "; version=1",
+			status:      200,
+			body:        `{"version":1,"name":"whoami","state":"starting","readyReplicas":1,"desiredReplicas":3,"lastTransitionTime":"2021-06-01T10:00:00Z","message":"pulling image","estimatedTimeToReady":"30s"}`,
+			expected: &ServiceStatus{
+				Name:                 "whoami",
+				State:                StateStarting,
+				ReadyReplicas:        1,
+				DesiredReplicas:      3,
+				LastTransitionTime:   time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC),
+				Message:              "pulling image",
+				EstimatedTimeToReady: 30 * time.Second,
+			},
+		},
+		{
+			desc:        "json without name",
+			contentType: "application/json",
+			status:      200,
+			body:        `{"version":1,"state":"started","readyReplicas":1,"desiredReplicas":1}`,
+			expected:    &ServiceStatus{Name: "whoami", State: StateStarted, ReadyReplicas: 1, DesiredReplicas: 1},
+		},
+		{
+			desc:        "json failed with error status",
+			contentType: "application/json",
+			status:      500,
+			body:        `{"version":1,"name":"whoami","state":"failed","message":"image not found"}`,
+			expected:    &ServiceStatus{Name: "whoami", State: StateFailed, Message: "image not found"},
+		},
+		{
+			desc:              "json starting with error status",
+			contentType:       "application/json",
+			status:            503,
+			body:              `{"version":1,"name":"whoami","state":"starting","message":"quota exceeded"}`,
+			expectedError:     true,
+			expectedErrorText: "the ondemand service answered 503 with the starting state: quota exceeded",
+		},
+		{
+			desc:              "json started with error status",
+			contentType:       StatusMediaType + "; version=1",
+			status:            502,
+			body:              `{"version":1,"state":"started"}`,
+			expectedError:     true,
+			expectedErrorText: "the ondemand service answered 502 with the started state",
+		},
+		{
+			desc:              "json malformed with error status",
+			contentType:       "application/json",
+			status:            502,
+			body:              `Bad Gateway`,
+			expectedError:     true,
+			expectedErrorText: "Bad Gateway",
+		},
+		{
+			desc:          "json unsupported version",
+			contentType:   "application/json",
+			status:        200,
+			body:          `{"version":2,"state":"started"}`,
+			expectedError: true,
+		},
+		{
+			desc:          "json invalid estimated time",
+			contentType:   "application/json",
+			status:        200,
+			body:          `{"version":1,"state":"starting","estimatedTimeToReady":"soon"}`,
+			expectedError: true,
+		},
+		{
+			desc:          "json malformed",
+			contentType:   "application/json",
+			status:        200,
+			body:          `started`,
+			expectedError: true,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Contains(t, r.Header.Get("Accept"), StatusMediaType)
+				w.Header().Set("Content-Type", test.contentType)
+				w.WriteHeader(test.status)
+				fmt.Fprint(w, test.body)
+			}))
+			defer mockServer.Close()
+
+			status, err := getServiceStatus(context.Background(), nil, "whoami", mockServer.URL+"/services/whoami/status?timeout=1m0s")
+
+			if test.expectedError {
+				assert.Error(t, err)
+				if len(test.expectedErrorText) != 0 {
+					assert.EqualError(t, err, test.expectedErrorText)
+				}
+			} else {
+				require.NoError(t, err)
+				assert.False(t, status.CheckedAt.IsZero())
+				status.CheckedAt = time.Time{}
+				assert.Equal(t, test.expected, status)
+			}
+		})
+	}
+}
@@ -1,39 +1,144 @@
 package strategy
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"strings"
 	"time"
-)
 
-// Net client is a custom client to timeout after 2 seconds if the service is not ready
-var netClient = &http.Client{
-	Timeout: time.Second * 2,
-}
+	"github.com/acouvreur/traefik-ondemand-plugin/pkg/client"
+)
 
 type Strategy interface {
 	ServeHTTP(rw http.ResponseWriter, req *http.Request)
 }
 
-func getServiceStatus(request string) (string, error) {
+// ReservedPrefix is the path segment of the endpoints served by the middleware itself
+const ReservedPrefix = "/__ondemand/"
+
+// ReservedEndpoint returns the name of the reserved endpoint targeted by the path, if any
+func ReservedEndpoint(path string) (string, bool) {
+	index := strings.Index(path, ReservedPrefix)
+	if index < 0 {
+		return "", false
+	}
+	return path[index+len(ReservedPrefix):], true
+}
+
+// ReservedURL returns the path of a reserved endpoint below the request path, so that it is routed to the same middleware
+func ReservedURL(req *http.Request, endpoint string) string {
+	// X-Forwarded-Prefix is set when a prefix was stripped before reaching the middleware
+	base := req.Header.Get("X-Forwarded-Prefix") + req.URL.Path
+	if index := strings.Index(base, ReservedPrefix); index >= 0 {
+		base = base[:index]
+	}
+	return strings.TrimSuffix(base, "/") + ReservedPrefix + endpoint
+}
+
+// AssetsURL returns the URL of the assets of the pages, assetsUrl when set and the assets endpoint at the root of the
+// router otherwise. It is the same for every page, so that the browsers download and cache the assets only once.
+func AssetsURL(req *http.Request, assetsUrl string) string {
+	if len(assetsUrl) != 0 {
+		return strings.TrimSuffix(assetsUrl, "/")
+	}
+	// X-Forwarded-Prefix is set when a prefix was stripped before reaching the middleware
+	return strings.TrimSuffix(req.Header.Get("X-Forwarded-Prefix"), "/") + ReservedPrefix + "assets"
+}
+
+// UnreachableError is returned when no answer could be received from the ondemand service
+type UnreachableError struct {
+	Err error
+}
+
+func (e *UnreachableError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *UnreachableError) Unwrap() error {
+	return e.Err
+}
+
+// Service is a service of the group and the request retrieving its status from the ondemand service
+type Service struct {
+	Name    string
+	Request string
+	// Timeout is the inactivity duration after which the ondemand service stops the service
+	Timeout time.Duration
+	// Wake and Activity are the operations sent in addition to the status request, when set
+	Wake     Operation
+	Activity Operation
+	// ReadyReplicas is the number of ready replicas from which a starting service is considered started,
+	// 0 waits for the ondemand service to report it started
+	ReadyReplicas int
+	// DependsOn are the services which must be started before this one is woken up
+	DependsOn []string
+	// Tier is the startup rank of the service computed from DependsOn by AssignTiers
+	Tier int
+}
+
+func getServiceStatus(ctx context.Context, doer client.Doer, name string, request string) (*ServiceStatus, error) {
+	if doer == nil {
+		doer = client.Default
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, request, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", statusAccept)
 
 	// This request wakes up the service if he's scaled to 0
-	resp, err := netClient.Get(request)
+	resp, err := doer.Do(req)
 	if err != nil {
-		return "error", err
+		return nil, &UnreachableError{Err: err}
 	}
 
 	defer resp.Body.Close()
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return "parsing error", err
+		return nil, &UnreachableError{Err: err}
 	}
 
+	contentType := resp.Header.Get("Content-Type")
+
+	var status *ServiceStatus
+
 	if resp.StatusCode >= 400 {
-		return "error from ondemand service", errors.New(string(body))
+		// A status document explains why the service failed, otherwise the body is the error
+		if !isStatusDocument(contentType) {
+			return nil, errors.New(string(body))
+		}
+		status, err = parseStatusDocument(body)
+		if err != nil {
+			return nil, errors.New(string(body))
+		}
+		if status.Err() == nil {
+			return nil, statusCodeError(resp.StatusCode, status)
+		}
+	} else {
+		status, err = parseServiceStatus(contentType, body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	status.CheckedAt = time.Now()
+
+	// Legacy services do not send the name back, it is not always in the request either with a path template
+	if len(status.Name) == 0 {
+		status.Name = name
 	}
 
-	return strings.TrimSuffix(string(body), "\n"), nil
+	return status, nil
+}
+
+// statusCodeError reports an error status code answered with a status document which is not in error
+func statusCodeError(code int, status *ServiceStatus) error {
+	if len(status.Message) != 0 {
+		return fmt.Errorf("the ondemand service answered %d with the %s state: %s", code, status.State, status.Message)
+	}
+	return fmt.Errorf("the ondemand service answered %d with the %s state", code, status.State)
 }
@@ -0,0 +1,129 @@
+package strategy
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReservedEndpoint(t *testing.T) {
+	testCases := []struct {
+		desc             string
+		path             string
+		expectedEndpoint string
+		expectedOk       bool
+	}{
+		{desc: "root", path: "/__ondemand/events", expectedEndpoint: "events", expectedOk: true},
+		{desc: "below the service path", path: "/whoami/page/__ondemand/events", expectedEndpoint: "events", expectedOk: true},
+		{desc: "service path", path: "/whoami/page", expectedOk: false},
+		{desc: "similar name", path: "/__ondemandevents", expectedOk: false},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			endpoint, ok := ReservedEndpoint(test.path)
+
+			assert.Equal(t, test.expectedOk, ok)
+			assert.Equal(t, test.expectedEndpoint, endpoint)
+		})
+	}
+}
+
+func TestReservedURL(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		url      string
+		prefix   string
+		expected string
+	}{
+		{desc: "root", url: "http://mydomain/", expected: "/__ondemand/events"},
+		{desc: "service path", url: "http://mydomain/whoami", expected: "/whoami/__ondemand/events"},
+		{desc: "trailing slash and query", url: "http://mydomain/whoami/?page=1", expected: "/whoami/__ondemand/events"},
+		{desc: "stripped prefix", url: "http://mydomain/page", prefix: "/whoami", expected: "/whoami/page/__ondemand/events"},
+		{desc: "already reserved", url: "http://mydomain/whoami/__ondemand/status", expected: "/whoami/__ondemand/events"},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, test.url, nil)
+			if len(test.prefix) != 0 {
+				req.Header.Set("X-Forwarded-Prefix", test.prefix)
+			}
+
+			assert.Equal(t, test.expected, ReservedURL(req, "events"))
+		})
+	}
+}
+
+func TestAssetsURL(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		url       string
+		prefix    string
+		assetsUrl string
+		expected  string
+	}{
+		{desc: "root", url: "http://mydomain/", expected: "/__ondemand/assets"},
+		{desc: "service path", url: "http://mydomain/whoami/page?id=1", expected: "/__ondemand/assets"},
+		{desc: "stripped prefix", url: "http://mydomain/page", prefix: "/whoami/", expected: "/whoami/__ondemand/assets"},
+		{desc: "configured", url: "http://mydomain/whoami/page", assetsUrl: "/whoami/__ondemand/assets/", expected: "/whoami/__ondemand/assets"},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, test.url, nil)
+			if len(test.prefix) != 0 {
+				req.Header.Set("X-Forwarded-Prefix", test.prefix)
+			}
+
+			assert.Equal(t, test.expected, AssetsURL(req, test.assetsUrl))
+		})
+	}
+}
+
+// strictResponseWriter is a ResponseRecorder recording every superfluous or misordered WriteHeader call
+type strictResponseWriter struct {
+	*httptest.ResponseRecorder
+	headerWritten bool
+	violations    []string
+}
+
+func newStrictResponseWriter() *strictResponseWriter {
+	return &strictResponseWriter{ResponseRecorder: httptest.NewRecorder()}
+}
+
+func (w *strictResponseWriter) WriteHeader(code int) {
+	if w.headerWritten {
+		w.violations = append(w.violations, fmt.Sprintf("superfluous WriteHeader(%d)", code))
+	}
+	w.headerWritten = true
+	w.ResponseRecorder.WriteHeader(code)
+}
+
+func (w *strictResponseWriter) Write(b []byte) (int, error) {
+	w.headerWritten = true
+	return w.ResponseRecorder.Write(b)
+}
+
+// failedServices returns the names of the services expected to fail with the test case responses
+func failedServices(responses []OnDemandServiceResponses) []string {
+	var names []string
+	for index, response := range responses {
+		if response.hang || (response.body != "started" && response.body != "starting") {
+			names = append(names, fmt.Sprintf("whoami-%d", index))
+		}
+	}
+	return names
+}
@@ -1,8 +1,17 @@
 package strategy
 
+import (
+	"net/http"
+	"time"
+)
+
 type OnDemandServiceResponses struct {
 	body   string
 	status int
+	// delay before the mock ondemand service answers
+	delay time.Duration
+	// hang makes the mock ondemand service never answer until released
+	hang bool
 }
 type ExpectedStatusForStrategy struct {
 	dynamic  int
@@ -13,6 +22,39 @@ type TestCase struct {
 	desc                     string
 	onDemandServiceResponses []OnDemandServiceResponses
 	expected                 ExpectedStatusForStrategy
+	// maxLatency is the upper bound of the strategy response time, 0 means unbounded
+	maxLatency time.Duration
+}
+
+// PollTimeout is the group poll timeout used with the test cases
+const PollTimeout = 500 * time.Millisecond
+
+// simulateLatency delays the mock ondemand service answer as described by the response
+func (response OnDemandServiceResponses) simulateLatency(req *http.Request, release <-chan struct{}) {
+	if response.hang {
+		select {
+		case <-req.Context().Done():
+		case <-release:
+		}
+		return
+	}
+	time.Sleep(response.delay)
+}
+
+// WithDelay returns the responses answered after delay
+func WithDelay(responses []OnDemandServiceResponses, delay time.Duration) []OnDemandServiceResponses {
+	for i := range responses {
+		responses[i].delay = delay
+	}
+	return responses
+}
+
+// Hanging returns the responses never answered
+func Hanging(responses []OnDemandServiceResponses) []OnDemandServiceResponses {
+	for i := range responses {
+		responses[i].hang = true
+	}
+	return responses
 }
 
 var SingleServiceTestCases = []TestCase{
@@ -124,6 +166,20 @@ var MultipleServicesTestCases = []TestCase{
 			blocking: 500,
 		},
 	},
+	{
+		desc: "two errored others are started",
+		onDemandServiceResponses: append(
+			GenerateServicesResponses(1, "error"),
+			append(
+				GenerateServicesResponses(2, "started"),
+				GenerateServicesResponses(1, "error")...,
+			)...,
+		),
+		expected: ExpectedStatusForStrategy{
+			dynamic:  500,
+			blocking: 500,
+		},
+	},
 	{
 		desc:                     "all are started",
 		onDemandServiceResponses: GenerateServicesResponses(5, "started"),
@@ -132,4 +188,46 @@ var MultipleServicesTestCases = []TestCase{
 			blocking: 200,
 		},
 	},
+	{
+		desc:                     "all are started but slow to answer",
+		onDemandServiceResponses: WithDelay(GenerateServicesResponses(10, "started"), 300*time.Millisecond),
+		expected: ExpectedStatusForStrategy{
+			dynamic:  200,
+			blocking: 200,
+		},
+		// Sequential checks would take 3 seconds
+		maxLatency: time.Second,
+	},
+	{
+		desc: "one slow starting others are started",
+		onDemandServiceResponses: append(
+			WithDelay(GenerateServicesResponses(1, "starting"), 300*time.Millisecond),
+			GenerateServicesResponses(4, "started")...,
+		),
+		expected: ExpectedStatusForStrategy{
+			dynamic:  202,
+			blocking: 503,
+		},
+	},
+	{
+		desc: "one hanging others are started",
+		onDemandServiceResponses: append(
+			Hanging(GenerateServicesResponses(1, "started")),
+			GenerateServicesResponses(4, "started")...,
+		),
+		expected: ExpectedStatusForStrategy{
+			dynamic:  500,
+			blocking: 500,
+		},
+		maxLatency: PollTimeout + 300*time.Millisecond,
+	},
+	{
+		desc:                     "all are hanging",
+		onDemandServiceResponses: Hanging(GenerateServicesResponses(10, "started")),
+		expected: ExpectedStatusForStrategy{
+			dynamic:  500,
+			blocking: 500,
+		},
+		maxLatency: PollTimeout + 300*time.Millisecond,
+	},
 }